@@ -42,6 +42,10 @@ provider "pexip" {
   username = var.infinity_username
   password = var.infinity_password
   insecure = true
+
+  # The Management node is built in the same apply, so wait for its API
+  wait_for_ready         = true
+  wait_for_ready_timeout = "20m"
 }
 
 provider "tls" {}
\ No newline at end of file
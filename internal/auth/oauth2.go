@@ -0,0 +1,292 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package auth provides authenticators for the Infinity Manager API that are
+// not covered by the go-infinity-sdk auth package.
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// TokenPath is the path of the OAuth2 token endpoint on the Infinity Manager.
+	TokenPath = "/oauth/token/"
+
+	// assertionLifetime is how long a signed client assertion is valid for.
+	assertionLifetime = 5 * time.Minute
+
+	// expiryDelta is how long before the token expires it is refreshed, so
+	// that requests in flight never carry an expired token.
+	expiryDelta = 60 * time.Second
+
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+// OAuth2ClientCredentials authenticates requests with a bearer token obtained
+// from the Infinity Manager token endpoint using the OAuth2 client credentials
+// grant. The client authenticates itself to the token endpoint with a JWT
+// assertion signed by its private key (private_key_jwt). Tokens are cached and
+// refreshed automatically shortly before they expire.
+type OAuth2ClientCredentials struct {
+	clientID   string
+	tokenURL   string
+	key        crypto.Signer
+	algorithm  string
+	httpClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+
+	// now is overridden in tests
+	now func() time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// NewOAuth2ClientCredentials creates an authenticator for the given client ID
+// and PEM encoded private key. Tokens are requested from tokenURL using
+// httpClient, which should share the TLS configuration of the API client.
+func NewOAuth2ClientCredentials(clientID, tokenURL string, privateKeyPEM []byte, httpClient *http.Client) (*OAuth2ClientCredentials, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("client ID cannot be empty")
+	}
+	if _, err := url.ParseRequestURI(tokenURL); err != nil {
+		return nil, fmt.Errorf("invalid token URL %q: %w", tokenURL, err)
+	}
+
+	key, err := ParsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	algorithm, err := signingAlgorithm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &OAuth2ClientCredentials{
+		clientID:   clientID,
+		tokenURL:   tokenURL,
+		key:        key,
+		algorithm:  algorithm,
+		httpClient: httpClient,
+		now:        time.Now,
+	}, nil
+}
+
+// ParsePrivateKey parses a PEM encoded PKCS#8, SEC 1 (EC) or PKCS#1 (RSA) private key.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("private key is not PEM encoded")
+	}
+
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+// signingAlgorithm returns the JWS algorithm used to sign assertions with key.
+func signingAlgorithm(key crypto.Signer) (string, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		switch k.Curve.Params().BitSize {
+		case 256:
+			return "ES256", nil
+		case 384:
+			return "ES384", nil
+		case 521:
+			return "ES512", nil
+		}
+		return "", fmt.Errorf("unsupported EC curve %s", k.Curve.Params().Name)
+	case *rsa.PrivateKey:
+		return "RS256", nil
+	}
+	return "", fmt.Errorf("unsupported private key type %T", key)
+}
+
+// Authenticate adds a bearer token to the HTTP request, fetching a new token
+// from the token endpoint if there is no valid cached token.
+func (o *OAuth2ClientCredentials) Authenticate(req *http.Request) error {
+	token, err := o.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Token returns a valid access token, requesting a new one when the cached
+// token is missing or about to expire.
+func (o *OAuth2ClientCredentials) Token(ctx context.Context) (string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.token != "" && o.now().Add(expiryDelta).Before(o.expiry) {
+		return o.token, nil
+	}
+
+	token, expiry, err := o.fetchToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	o.token = token
+	o.expiry = expiry
+	return o.token, nil
+}
+
+func (o *OAuth2ClientCredentials) fetchToken(ctx context.Context) (string, time.Time, error) {
+	issuedAt := o.now()
+	assertion, err := o.signAssertion(issuedAt)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign client assertion: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_assertion_type", clientAssertionType)
+	form.Set("client_assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to request OAuth2 token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to read OAuth2 token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("OAuth2 token request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var token tokenResponse
+	if err = json.Unmarshal(body, &token); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to parse OAuth2 token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", time.Time{}, errors.New("OAuth2 token response did not contain an access token")
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return "", time.Time{}, fmt.Errorf("unsupported OAuth2 token type %q", token.TokenType)
+	}
+
+	return token.AccessToken, issuedAt.Add(time.Duration(token.ExpiresIn) * time.Second), nil
+}
+
+// signAssertion builds and signs the JWT used to authenticate the client to
+// the token endpoint, as described in RFC 7523.
+func (o *OAuth2ClientCredentials) signAssertion(issuedAt time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": o.algorithm,
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss": o.clientID,
+		"sub": o.clientID,
+		"aud": o.tokenURL,
+		"jti": uuid.NewString(),
+		"iat": issuedAt.Unix(),
+		"exp": issuedAt.Add(assertionLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	signature, err := o.sign([]byte(signingInput))
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (o *OAuth2ClientCredentials) sign(data []byte) ([]byte, error) {
+	switch key := o.key.(type) {
+	case *ecdsa.PrivateKey:
+		var digest []byte
+		switch o.algorithm {
+		case "ES384":
+			sum := sha512.Sum384(data)
+			digest = sum[:]
+		case "ES512":
+			sum := sha512.Sum512(data)
+			digest = sum[:]
+		default:
+			sum := sha256.Sum256(data)
+			digest = sum[:]
+		}
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			return nil, err
+		}
+		// JWS uses the fixed width concatenation of r and s rather than ASN.1
+		size := (key.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+		return signature, nil
+	case *rsa.PrivateKey:
+		sum := sha256.Sum256(data)
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	}
+	return nil, fmt.Errorf("unsupported private key type %T", o.key)
+}
@@ -0,0 +1,209 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func generateECKeyPEM(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+// newTokenServer returns a token endpoint that validates the client assertion
+// against pub and issues numbered tokens valid for expiresIn seconds.
+func newTokenServer(t *testing.T, pub *ecdsa.PublicKey, expiresIn int, calls *int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(calls, 1)
+
+		if r.Method != http.MethodPost || r.URL.Path != TokenPath {
+			http.Error(w, "unexpected request", http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("client_assertion_type") != clientAssertionType {
+			http.Error(w, `{"error": "unsupported_grant_type"}`, http.StatusBadRequest)
+			return
+		}
+
+		parts := strings.Split(r.PostForm.Get("client_assertion"), ".")
+		if len(parts) != 3 {
+			http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+		signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+		if !verifyES256(pub, []byte(parts[0]+"."+parts[1]), signature) {
+			http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+
+		var claims map[string]interface{}
+		payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		if err := json.Unmarshal(payload, &claims); err != nil || claims["iss"] != "client-id" || claims["sub"] != "client-id" {
+			http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+		if claims["aud"] != "http://"+r.Host+TokenPath {
+			http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": %d}`, n, expiresIn)
+	}))
+}
+
+func verifyES256(pub *ecdsa.PublicKey, data, signature []byte) bool {
+	if len(signature) != 64 {
+		return false
+	}
+	sum := sha256.Sum256(data)
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	return ecdsa.Verify(pub, sum[:], r, s)
+}
+
+func TestOAuth2ClientCredentials_Authenticate(t *testing.T) {
+	key, keyPEM := generateECKeyPEM(t)
+	var calls int32
+	server := newTokenServer(t, &key.PublicKey, 3600, &calls)
+	defer server.Close()
+
+	authenticator, err := NewOAuth2ClientCredentials("client-id", server.URL+TokenPath, keyPEM, server.Client())
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://manager.example.com/api/admin/status/v1/worker_vm/", nil)
+		require.NoError(t, authenticator.Authenticate(req))
+		require.Equal(t, "Bearer token-1", req.Header.Get("Authorization"))
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls), "token should be cached between requests")
+}
+
+func TestOAuth2ClientCredentials_Refresh(t *testing.T) {
+	key, keyPEM := generateECKeyPEM(t)
+	var calls int32
+	server := newTokenServer(t, &key.PublicKey, 300, &calls)
+	defer server.Close()
+
+	authenticator, err := NewOAuth2ClientCredentials("client-id", server.URL+TokenPath, keyPEM, server.Client())
+	require.NoError(t, err)
+
+	now := time.Now()
+	authenticator.now = func() time.Time { return now }
+
+	token, err := authenticator.Token(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token)
+
+	// Still well within the token lifetime
+	now = now.Add(200 * time.Second)
+	token, err = authenticator.Token(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token)
+
+	// Inside the expiry window, so the token is refreshed
+	now = now.Add(60 * time.Second)
+	token, err = authenticator.Token(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "token-2", token)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestOAuth2ClientCredentials_TokenError(t *testing.T) {
+	_, keyPEM := generateECKeyPEM(t)
+	other, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	var calls int32
+	// The server expects a different key, so the assertion is rejected
+	server := newTokenServer(t, &other.PublicKey, 3600, &calls)
+	defer server.Close()
+
+	authenticator, err := NewOAuth2ClientCredentials("client-id", server.URL+TokenPath, keyPEM, server.Client())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "https://manager.example.com/api/admin/status/v1/worker_vm/", nil)
+	err = authenticator.Authenticate(req)
+	require.ErrorContains(t, err, "status 401")
+	require.Empty(t, req.Header.Get("Authorization"))
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		pem       []byte
+		algorithm string
+		wantErr   string
+	}{
+		{
+			name:      "SEC 1 EC key",
+			pem:       pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDER}),
+			algorithm: "ES384",
+		},
+		{
+			name:      "PKCS#1 RSA key",
+			pem:       pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+			algorithm: "RS256",
+		},
+		{
+			name:    "not PEM",
+			pem:     []byte("not a key"),
+			wantErr: "not PEM encoded",
+		},
+		{
+			name:    "certificate",
+			pem:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte{0}}),
+			wantErr: "unsupported PEM block type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePrivateKey(tt.pem)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			algorithm, err := signingAlgorithm(key)
+			require.NoError(t, err)
+			require.Equal(t, tt.algorithm, algorithm)
+		})
+	}
+}
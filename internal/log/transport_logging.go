@@ -2,20 +2,56 @@ package log
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	// DefaultMaxBodyLength is the default maximum number of bytes to log from request/response bodies
 	DefaultMaxBodyLength = 1000
+
+	// HTTPSubsystem is the tflog subsystem for API requests. Its level can be
+	// set separately with TF_LOG_PROVIDER_PEXIP_HTTP.
+	HTTPSubsystem = "http"
+
+	redacted = "[REDACTED]"
 )
 
+// redactedHeaders are never logged in full.
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Csrftoken"}
+
+// DefaultRedactFields are JSON and form fields that are always redacted, in
+// addition to the fields configured on the transport. They cover the
+// credentials exchanged with the Manager's OAuth2 token endpoint, and the
+// passphrase of backups.
+var DefaultRedactFields = []string{"password", "passphrase", "client_assertion", "access_token", "refresh_token", "id_token"}
+
 type LoggingTransport struct {
 	Base          http.RoundTripper
-	MaxBodyLength int // Maximum number of bytes to log from request/response bodies. 0 = use default (1000), negative = unlimited.
+	MaxBodyLength int      // Maximum number of bytes to log from request/response bodies. 0 = use default (1000), negative = unlimited.
+	RedactFields  []string // JSON and form fields whose values are replaced with [REDACTED], in addition to DefaultRedactFields.
+}
+
+// HTTPLoggingEnabled reports whether TF_LOG, TF_LOG_PROVIDER or
+// TF_LOG_PROVIDER_PEXIP_HTTP asks for DEBUG or TRACE output, which is when the
+// provider logs API requests.
+func HTTPLoggingEnabled() bool {
+	for _, env := range []string{"TF_LOG", "TF_LOG_PROVIDER", "TF_LOG_PROVIDER_PEXIP_HTTP"} {
+		switch strings.ToUpper(os.Getenv(env)) {
+		case "DEBUG", "TRACE":
+			return true
+		}
+	}
+	return false
 }
 
 // truncateBody truncates the body to the maximum length and adds a truncation message if needed
@@ -39,30 +75,152 @@ func (t *LoggingTransport) truncateBody(body []byte) string {
 }
 
 func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), HTTPSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_PEXIP", HTTPSubsystem))
 	start := time.Now()
 
 	// Read and log request body if it exists
-	var reqBody []byte
-	if req.Body != nil {
-		reqBody, _ = io.ReadAll(req.Body)
-		req.Body = io.NopCloser(bytes.NewReader(reqBody)) // reattach
+	reqFields := map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": t.headers(req.Header),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		if loggableBody(req.Header) {
+			reqBody, _ := io.ReadAll(req.Body)
+			req.Body = io.NopCloser(bytes.NewReader(reqBody)) // reattach
+			reqFields["body"] = t.formatBody(req.Header, reqBody)
+		} else {
+			reqFields["body"] = bodyNotLogged(req.Header)
+		}
 	}
-	fmt.Printf("\n--> %s %s\n%s\n", req.Method, req.URL, t.truncateBody(reqBody))
+	tflog.SubsystemDebug(ctx, HTTPSubsystem, fmt.Sprintf("--> %s %s", req.Method, req.URL.Path), reqFields)
 
 	// Send the request
 	resp, err := t.Base.RoundTrip(req)
 	if err != nil {
-		fmt.Printf("<-- ERROR: %v (%v)\n", err, time.Since(start))
+		tflog.SubsystemDebug(ctx, HTTPSubsystem, fmt.Sprintf("<-- ERROR %s %s", req.Method, req.URL.Path), map[string]interface{}{
+			"error":    err.Error(),
+			"duration": time.Since(start).String(),
+		})
 		return nil, err
 	}
 
 	// Read and log response body (non-destructive)
-	var respBody []byte
+	respFields := map[string]interface{}{
+		"status":   resp.StatusCode,
+		"duration": time.Since(start).String(),
+		"headers":  t.headers(resp.Header),
+	}
 	if resp.Body != nil {
-		respBody, _ = io.ReadAll(resp.Body)
-		resp.Body = io.NopCloser(bytes.NewReader(respBody)) // reattach
+		if loggableBody(resp.Header) {
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body = io.NopCloser(bytes.NewReader(respBody)) // reattach
+			respFields["body"] = t.formatBody(resp.Header, respBody)
+		} else {
+			respFields["body"] = bodyNotLogged(resp.Header)
+		}
 	}
-	fmt.Printf("<-- %s %s (%v)\n%s\n", resp.Status, req.URL, time.Since(start), t.truncateBody(respBody))
+	tflog.SubsystemDebug(ctx, HTTPSubsystem, fmt.Sprintf("<-- %s %s %s", resp.Status, req.Method, req.URL.Path), respFields)
 
 	return resp, nil
 }
+
+// headers returns the headers to log, with credentials redacted.
+func (t *LoggingTransport) headers(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name := range header {
+		headers[name] = header.Get(name)
+	}
+	for _, name := range redactedHeaders {
+		if _, ok := headers[name]; ok {
+			headers[name] = redacted
+		}
+	}
+	return headers
+}
+
+// formatBody redacts the sensitive fields in a JSON or form encoded body and
+// truncates it to the maximum length.
+func (t *LoggingTransport) formatBody(header http.Header, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "[unparseable form body not logged]"
+		}
+		for key := range values {
+			if t.isRedacted(key) {
+				values[key] = []string{redacted}
+			}
+		}
+		body = []byte(values.Encode())
+	case strings.HasSuffix(mediaType, "json") || json.Valid(body):
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err != nil {
+			return "[unparseable JSON body not logged]"
+		}
+		if redactedBody, err := json.Marshal(t.redactJSON(value)); err == nil {
+			body = redactedBody
+		}
+	}
+
+	return t.truncateBody(body)
+}
+
+// redactJSON replaces the values of sensitive fields anywhere in value.
+func (t *LoggingTransport) redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if t.isRedacted(key) && field != nil && field != "" {
+				v[key] = redacted
+			} else {
+				v[key] = t.redactJSON(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = t.redactJSON(element)
+		}
+	}
+	return value
+}
+
+func (t *LoggingTransport) isRedacted(field string) bool {
+	for _, fields := range [][]string{DefaultRedactFields, t.RedactFields} {
+		for _, name := range fields {
+			if strings.EqualFold(field, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// loggableBody reports whether a body is small and textual enough to log.
+// Uploads and downloads such as backups and upgrade packages are streamed and
+// must not be buffered in memory, and multipart bodies may contain private keys.
+// A body without a Content-Type may be either, so it is not logged.
+func loggableBody(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return strings.HasSuffix(mediaType, "json") ||
+		mediaType == "application/x-www-form-urlencoded" ||
+		strings.HasPrefix(mediaType, "text/")
+}
+
+// bodyNotLogged returns the placeholder logged instead of a body that
+// loggableBody rejects.
+func bodyNotLogged(header http.Header) string {
+	if contentType := header.Get("Content-Type"); contentType != "" {
+		return fmt.Sprintf("[%s body not logged]", contentType)
+	}
+	return "[body not logged]"
+}
@@ -2,11 +2,15 @@ package log
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 )
 
 func TestLoggingTransport_DefaultMaxBodyLength(t *testing.T) {
@@ -121,3 +125,156 @@ func TestLoggingTransport_TruncateBody(t *testing.T) {
 		})
 	}
 }
+
+func TestLoggingTransport_Redaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "sessionid=abc123")
+		w.Write([]byte(`{"name": "ldap", "ldap_bind_password": "hunter2", "nested": [{"password": "hunter3"}]}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	transport := &LoggingTransport{
+		Base:         http.DefaultTransport,
+		RedactFields: []string{"ldap_bind_password"},
+	}
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(`{"name": "ldap", "ldap_bind_password": "hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth("admin", "hunter2")
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// The response body must still be readable after logging
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "hunter2") {
+		t.Errorf("Expected the original response body, got: %s", body)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Failed to decode log output: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d: %s", len(entries), output.String())
+	}
+	for _, entry := range entries {
+		if entry["@module"] != "provider.http" {
+			t.Errorf("Expected entry in the http subsystem, got: %v", entry["@module"])
+		}
+		logged, _ := json.Marshal(entry)
+		if strings.Contains(string(logged), "hunter") || strings.Contains(string(logged), "abc123") {
+			t.Errorf("Expected secrets to be redacted, got: %s", logged)
+		}
+		if !strings.Contains(string(logged), `\"name\":\"ldap\"`) {
+			t.Errorf("Expected non-sensitive fields to be logged, got: %s", logged)
+		}
+	}
+}
+
+func TestLoggingTransport_FormRedaction(t *testing.T) {
+	transport := &LoggingTransport{}
+	header := http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}}
+
+	formatted := transport.formatBody(header, []byte("grant_type=client_credentials&client_assertion=eyJhbGciOi"))
+	if strings.Contains(formatted, "eyJhbGciOi") || !strings.Contains(formatted, "grant_type=client_credentials") {
+		t.Errorf("Expected client_assertion to be redacted, got: %s", formatted)
+	}
+}
+
+func TestLoggingTransport_PassphraseRedaction(t *testing.T) {
+	// The backup action cannot mark its passphrase as sensitive, so it is
+	// redacted from the body of command/v1/backup/create/ by default
+	transport := &LoggingTransport{}
+	header := http.Header{"Content-Type": []string{"application/json"}}
+
+	formatted := transport.formatBody(header, []byte(`{"request": true, "passphrase": "correct horse battery staple"}`))
+	if strings.Contains(formatted, "correct horse") || !strings.Contains(formatted, `"request":true`) {
+		t.Errorf("Expected passphrase to be redacted, got: %s", formatted)
+	}
+}
+
+func TestLoggingTransport_BinaryBodyNotLogged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("backup archive"))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader("--boundary\r\nprivate key\r\n--boundary--"))
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=boundary")
+	resp, err := (&http.Client{Transport: &LoggingTransport{Base: http.DefaultTransport}}).Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if strings.Contains(output.String(), "private key") || strings.Contains(output.String(), "backup archive") {
+		t.Errorf("Expected multipart and binary bodies not to be logged, got: %s", output.String())
+	}
+	if body, _ := io.ReadAll(resp.Body); string(body) != "backup archive" {
+		t.Errorf("Expected the original response body, got: %s", body)
+	}
+}
+
+func TestLoggingTransport_UntypedBodyNotLogged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Stop the server from sniffing a Content-Type from the body
+		w.Header()["Content-Type"] = nil
+		w.Write([]byte("upgrade package"))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := (&http.Client{Transport: &LoggingTransport{Base: http.DefaultTransport}}).Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if strings.Contains(output.String(), "upgrade package") || !strings.Contains(output.String(), "[body not logged]") {
+		t.Errorf("Expected a body without a Content-Type not to be logged, got: %s", output.String())
+	}
+	if body, _ := io.ReadAll(resp.Body); string(body) != "upgrade package" {
+		t.Errorf("Expected the original response body, got: %s", body)
+	}
+}
+
+func TestHTTPLoggingEnabled(t *testing.T) {
+	tests := []struct {
+		env     string
+		value   string
+		enabled bool
+	}{
+		{"TF_LOG", "", false},
+		{"TF_LOG", "INFO", false},
+		{"TF_LOG", "debug", true},
+		{"TF_LOG", "TRACE", true},
+		{"TF_LOG_PROVIDER", "DEBUG", true},
+		{"TF_LOG_PROVIDER_PEXIP_HTTP", "TRACE", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env+"="+tt.value, func(t *testing.T) {
+			for _, env := range []string{"TF_LOG", "TF_LOG_PROVIDER", "TF_LOG_PROVIDER_PEXIP_HTTP"} {
+				t.Setenv(env, "")
+			}
+			t.Setenv(tt.env, tt.value)
+			if got := HTTPLoggingEnabled(); got != tt.enabled {
+				t.Errorf("Expected %v, got %v", tt.enabled, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pexip/go-infinity-sdk/v38/status"
+
+	"github.com/pexip/terraform-provider-pexip/internal/provider/validators"
+)
+
+var (
+	_ action.Action              = (*InfinityBackupAction)(nil)
+	_ action.ActionWithConfigure = (*InfinityBackupAction)(nil)
+)
+
+const (
+	defaultBackupTimeout      = 30 * time.Minute
+	defaultBackupPollInterval = 5 * time.Second
+)
+
+type InfinityBackupAction struct {
+	InfinityClient InfinityClient
+
+	files        *fileClient
+	pollInterval time.Duration
+}
+
+type InfinityBackupActionModel struct {
+	Passphrase types.String `tfsdk:"passphrase"`
+	Path       types.String `tfsdk:"path"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+func (a *InfinityBackupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_backup"
+}
+
+func (a *InfinityBackupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+
+	a.InfinityClient = p.client
+	a.files = p.files
+}
+
+func (a *InfinityBackupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"passphrase": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The passphrase the backup is encrypted with. It is needed to restore the backup. Action attributes cannot be marked as sensitive, so set it from a sensitive or ephemeral variable to keep it out of the plan output.",
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Local path to download the encrypted backup archive to. If it is an existing directory, the archive is saved in it under the name given by the Manager.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "How long to wait for the Manager to create the backup, e.g. `1h`. Defaults to `30m`.",
+			},
+		},
+		MarkdownDescription: "Creates an encrypted backup of the Manager's configuration and downloads it, e.g. before a risky apply. The download is checked against the size given by the Manager, and its SHA-256 checksum is reported.",
+	}
+}
+
+func (a *InfinityBackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InfinityBackupActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if checkReadOnly(a.InfinityClient, "create a backup", &resp.Diagnostics) {
+		return
+	}
+	if a.files == nil {
+		resp.Diagnostics.AddError(
+			"File Transfers Not Configured",
+			"The provider has no client for file transfers. Please report this issue to the provider developers",
+		)
+		return
+	}
+
+	timeout := defaultBackupTimeout
+	if !data.Timeout.IsNull() {
+		// The value has been checked by the validator
+		timeout, _ = time.ParseDuration(data.Timeout.ValueString())
+	}
+	pollInterval := a.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultBackupPollInterval
+	}
+
+	// Backups are created as backup requests, which give the URI to download
+	// the archive from. Earlier requests are ignored when looking for ours.
+	before, err := a.listBackupRequests(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity backup requests",
+			fmt.Sprintf("Could not list backup requests: %s", err),
+		)
+		return
+	}
+	earlier := make(map[string]bool, len(before))
+	for _, request := range before {
+		earlier[request.ResourceURI] = true
+	}
+
+	tflog.Info(ctx, "Creating Infinity backup")
+	if _, err := a.InfinityClient.Command().CreateBackup(ctx, data.Passphrase.ValueString(), true); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Infinity backup",
+			fmt.Sprintf("Could not create backup: %s", err),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Creating backup"})
+
+	var backup status.BackupRequest
+	state := ""
+	err = pollUntil(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		requests, err := a.listBackupRequests(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, request := range requests {
+			if earlier[request.ResourceURI] {
+				continue
+			}
+			backup = request
+			if request.State != state {
+				state = request.State
+				resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Backup is %s", request.State)})
+			}
+			if requestFailed(request.State) {
+				return false, fmt.Errorf("backup %s: %s", request.State, request.Message)
+			}
+			return request.DownloadURI != "", nil
+		}
+		return false, nil
+	})
+	if errors.Is(err, errPollTimeout) {
+		resp.Diagnostics.AddError(
+			"Infinity Backup Timed Out",
+			fmt.Sprintf("The Manager did not create the backup within %s.", timeout),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Infinity backup",
+			fmt.Sprintf("Could not create backup: %s", err),
+		)
+		return
+	}
+
+	target := downloadTarget(data.Path.ValueString(), backup.DownloadURI)
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Downloading backup to %s", target)})
+
+	size, checksum, err := downloadFile(ctx, a.files, backup.DownloadURI, target)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Downloading Infinity backup",
+			fmt.Sprintf("Could not download backup to %s: %s", target, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Downloaded Infinity backup to %s", target))
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Downloaded backup to %s: %d bytes, SHA-256 %s", target, size, checksum),
+	})
+}
+
+func (a *InfinityBackupAction) listBackupRequests(ctx context.Context) ([]status.BackupRequest, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]status.BackupRequest, string, error) {
+		page, err := a.InfinityClient.Status().ListBackupRequests(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+}
+
+// requestFailed reports whether the state of a backup or snapshot request
+// means that the Manager could not create it.
+func requestFailed(state string) bool {
+	state = strings.ToLower(state)
+	return strings.Contains(state, "fail") || strings.Contains(state, "error")
+}
+
+// downloadTarget returns the local path to download a file to. If target is an
+// existing directory, the file is saved in it under the name in downloadURI.
+func downloadTarget(target, downloadURI string) string {
+	if info, err := os.Stat(target); err == nil && info.IsDir() {
+		return filepath.Join(target, path.Base(strings.TrimSuffix(downloadURI, "/")))
+	}
+	return target
+}
+
+// downloadFile downloads the file at endpoint to target. The file is written
+// to a temporary file next to target first, and only moved into place once its
+// size matches the length given by the Manager and its checksum matches the
+// one of the bytes received. It returns the size and SHA-256 checksum of the
+// file.
+func downloadFile(ctx context.Context, files *fileClient, endpoint, target string) (int64, string, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*")
+	if err != nil {
+		return 0, "", err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	written, length, err := files.download(ctx, endpoint, io.MultiWriter(tmp, hash))
+	if err != nil {
+		return 0, "", err
+	}
+	if length >= 0 && written != length {
+		return 0, "", fmt.Errorf("received %d bytes, but the Manager announced %d", written, length)
+	}
+	if err := tmp.Sync(); err != nil {
+		return 0, "", err
+	}
+	checksum := hex.EncodeToString(hash.Sum(nil))
+
+	// Read the file back to make sure it was written intact
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return 0, "", err
+	}
+	hash.Reset()
+	if _, err := io.Copy(hash, tmp); err != nil {
+		return 0, "", err
+	}
+	if written := hex.EncodeToString(hash.Sum(nil)); written != checksum {
+		return 0, "", fmt.Errorf("checksum of the file written, %s, does not match the checksum of the download, %s", written, checksum)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return 0, "", err
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return 0, "", err
+	}
+	return written, checksum, nil
+}
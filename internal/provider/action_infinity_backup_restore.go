@@ -0,0 +1,258 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/config"
+
+	"github.com/pexip/terraform-provider-pexip/internal/provider/validators"
+)
+
+var (
+	_ action.Action              = (*InfinityBackupRestoreAction)(nil)
+	_ action.ActionWithConfigure = (*InfinityBackupRestoreAction)(nil)
+)
+
+const (
+	defaultBackupRestoreTimeout = 30 * time.Minute
+	// defaultBackupRestoreRestartGrace is how long the Manager is given to go
+	// down after a restore. If it keeps answering, it is assumed to have
+	// restored the backup without restarting.
+	defaultBackupRestoreRestartGrace = 2 * time.Minute
+)
+
+type InfinityBackupRestoreAction struct {
+	InfinityClient InfinityClient
+
+	files        *fileClient
+	pollInterval time.Duration
+	restartGrace time.Duration
+}
+
+type InfinityBackupRestoreActionModel struct {
+	Path            types.String `tfsdk:"path"`
+	Passphrase      types.String `tfsdk:"passphrase"`
+	ConfirmHostname types.String `tfsdk:"confirm_hostname"`
+	Timeout         types.String `tfsdk:"timeout"`
+}
+
+func (a *InfinityBackupRestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_backup_restore"
+}
+
+func (a *InfinityBackupRestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+
+	a.InfinityClient = p.client
+	a.files = p.files
+}
+
+func (a *InfinityBackupRestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Local path of the encrypted backup archive to restore, e.g. one downloaded by `pexip_infinity_backup`.",
+			},
+			"passphrase": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The passphrase the backup was encrypted with. Action attributes cannot be marked as sensitive, so set it from a sensitive or ephemeral variable to keep it out of the plan output.",
+			},
+			"confirm_hostname": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The hostname of the Manager the backup is restored to, either on its own or with the domain. The action refuses to run if it does not match the Manager the provider is connected to.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "How long to wait for the Manager to come back after the restore, e.g. `1h`. Defaults to `30m`.",
+			},
+		},
+		MarkdownDescription: "Restores an encrypted backup to the Manager and waits for it to come back. This replaces the Manager's configuration, so the action only runs if `confirm_hostname` matches the Manager's hostname.",
+	}
+}
+
+func (a *InfinityBackupRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InfinityBackupRestoreActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if checkReadOnly(a.InfinityClient, "restore a backup", &resp.Diagnostics) {
+		return
+	}
+	if a.files == nil {
+		resp.Diagnostics.AddError(
+			"File Transfers Not Configured",
+			"The provider has no client for file transfers. Please report this issue to the provider developers",
+		)
+		return
+	}
+
+	timeout := defaultBackupRestoreTimeout
+	if !data.Timeout.IsNull() {
+		// The value has been checked by the validator
+		timeout, _ = time.ParseDuration(data.Timeout.ValueString())
+	}
+	pollInterval := a.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultBackupPollInterval
+	}
+	restartGrace := a.restartGrace
+	if restartGrace == 0 {
+		restartGrace = defaultBackupRestoreRestartGrace
+	}
+
+	manager, err := a.InfinityClient.Config().GetManagementVM(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity management VM",
+			fmt.Sprintf("Could not read the Manager's hostname: %s", err),
+		)
+		return
+	}
+	hostname := managerHostname(manager)
+	if !hostnameMatches(manager, data.ConfirmHostname.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("confirm_hostname"),
+			"Manager Hostname Mismatch",
+			fmt.Sprintf("confirm_hostname is %q, but the provider is connected to the Manager %s. The backup was not restored.", data.ConfirmHostname.ValueString(), hostname),
+		)
+		return
+	}
+
+	archive, err := os.Open(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Error Reading Backup",
+			fmt.Sprintf("Could not open the backup archive: %s", err),
+		)
+		return
+	}
+	defer archive.Close()
+	info, err := archive.Stat()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Error Reading Backup",
+			fmt.Sprintf("Could not read the backup archive: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Restoring Infinity backup %s to %s", data.Path.ValueString(), hostname))
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Uploading backup %s (%d bytes) to %s", data.Path.ValueString(), info.Size(), hostname),
+	})
+
+	hash := sha256.New()
+	var result command.CommandResponse
+	err = a.files.upload(ctx, "command/v1/backup/restore/", map[string]string{
+		"passphrase": data.Passphrase.ValueString(),
+	}, "package", filepath.Base(data.Path.ValueString()), io.TeeReader(archive, hash), &result)
+	if err == nil && result.Status != "" && !strings.EqualFold(result.Status, "success") {
+		err = fmt.Errorf("%s: %s", result.Status, result.Message)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Restoring Infinity backup",
+			fmt.Sprintf("Could not restore backup: %s", err),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Uploaded backup with SHA-256 %s, waiting for the Manager to come back", hex.EncodeToString(hash.Sum(nil))),
+	})
+
+	// The Manager restarts to apply the backup. Wait for it to go down and
+	// answer again, or for the grace period if it never goes down.
+	restored := time.Now()
+	down := false
+	var lastErr error
+	err = pollUntil(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		if _, err := a.InfinityClient.Config().GetManagementVM(ctx); err != nil {
+			if !down {
+				down = true
+				resp.SendProgress(action.InvokeProgressEvent{Message: "Manager is restarting"})
+			}
+			lastErr = err
+			tflog.Debug(ctx, fmt.Sprintf("Manager is not available yet: %s", err))
+			return false, nil
+		}
+		return down || time.Since(restored) >= restartGrace, nil
+	})
+	if errors.Is(err, errPollTimeout) {
+		detail := fmt.Sprintf("The Manager did not come back within %s of restoring the backup.", timeout)
+		if lastErr != nil {
+			detail += fmt.Sprintf(" Last error: %s", lastErr)
+		}
+		resp.Diagnostics.AddError("Infinity Backup Restore Timed Out", detail)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Restoring Infinity backup",
+			fmt.Sprintf("Could not wait for the Manager: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Restored Infinity backup to %s", hostname))
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restored backup to %s", hostname)})
+}
+
+// managerHostname returns the fully qualified hostname of the Manager.
+func managerHostname(manager *config.ManagementVM) string {
+	if manager.Domain == "" {
+		return manager.Hostname
+	}
+	return manager.Hostname + "." + manager.Domain
+}
+
+// hostnameMatches reports whether hostname is the Manager's hostname, on its
+// own or with the domain.
+func hostnameMatches(manager *config.ManagementVM, hostname string) bool {
+	hostname = strings.TrimSuffix(strings.TrimSpace(hostname), ".")
+	if hostname == "" || manager.Hostname == "" {
+		return false
+	}
+	return strings.EqualFold(hostname, manager.Hostname) || strings.EqualFold(hostname, managerHostname(manager))
+}
@@ -0,0 +1,255 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/log"
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+// restoreManager stands in for the Manager's restore API. After a restore it
+// is unavailable for a number of polls, as if it were restarting.
+type restoreManager struct {
+	t *testing.T
+	// downPolls is the number of polls the Manager is unavailable for after a
+	// restore, or -1 if it never comes back
+	downPolls int
+	// rejectWith makes the Manager reject the restore with this message
+	rejectWith string
+
+	mu         sync.Mutex
+	down       int
+	restored   bool
+	passphrase string
+	filename   string
+	archive    []byte
+}
+
+func (m *restoreManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/admin/configuration/v1/management_vm/1/":
+		if m.down != 0 {
+			m.down--
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		require.NoError(m.t, json.NewEncoder(w).Encode(config.ManagementVM{ID: 1, Name: "mgr", Hostname: "mgr01", Domain: "example.com"}))
+	case r.Method == http.MethodPost && r.URL.Path == "/api/admin/command/v1/backup/restore/":
+		if m.rejectWith != "" {
+			http.Error(w, m.rejectWith, http.StatusBadRequest)
+			return
+		}
+		m.passphrase = r.FormValue("passphrase")
+		file, header, err := r.FormFile("package")
+		require.NoError(m.t, err)
+		defer file.Close()
+		m.filename = header.Filename
+		m.archive, err = io.ReadAll(file)
+		require.NoError(m.t, err)
+		m.restored = true
+		m.down = m.downPolls
+		require.NoError(m.t, json.NewEncoder(w).Encode(command.CommandResponse{Status: "success"}))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestBackupRestoreAction(t *testing.T, manager http.Handler) *InfinityBackupRestoreAction {
+	server := httptest.NewServer(manager)
+	t.Cleanup(server.Close)
+
+	client, err := infinity.New(infinity.WithBaseURL(server.URL), infinity.WithBasicAuth("admin", "admin"), infinity.WithNoRetries())
+	require.NoError(t, err)
+	files, err := newFileClient(server.URL, server.Client(), nil, "")
+	require.NoError(t, err)
+	return &InfinityBackupRestoreAction{InfinityClient: client, files: files, pollInterval: time.Millisecond, restartGrace: time.Hour}
+}
+
+func TestInfinityBackupRestoreAction(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	client.On("GetJSON", mock.Anything, "configuration/v1/management_vm/1/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		manager := args.Get(3).(*config.ManagementVM)
+		manager.Hostname = "mgr01"
+		manager.Domain = "example.com"
+	}).Maybe()
+
+	testInfinityBackupRestoreAction(t, client)
+}
+
+func testInfinityBackupRestoreAction(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config:      test.LoadTestFolder(t, "action_infinity_backup_restore_basic"),
+				ExpectError: regexp.MustCompile("Manager Hostname Mismatch"),
+			},
+		},
+	})
+}
+
+func TestInfinityBackupRestoreAction_Invoke(t *testing.T) {
+	archive := []byte("-----BEGIN PGP MESSAGE-----\nencrypted backup\n-----END PGP MESSAGE-----\n")
+	sum := sha256.Sum256(archive)
+	backup := filepath.Join(t.TempDir(), "pexip_backup.tar.gpg")
+	require.NoError(t, os.WriteFile(backup, archive, 0o600))
+
+	restore := func(hostname string, extra map[string]tftypes.Value) map[string]tftypes.Value {
+		attrs := map[string]tftypes.Value{
+			"path":             tftypes.NewValue(tftypes.String, backup),
+			"passphrase":       tftypes.NewValue(tftypes.String, "correct horse battery staple"),
+			"confirm_hostname": tftypes.NewValue(tftypes.String, hostname),
+		}
+		for name, value := range extra {
+			attrs[name] = value
+		}
+		return attrs
+	}
+
+	t.Run("restart", func(t *testing.T) {
+		manager := &restoreManager{t: t, downPolls: 2}
+		a := newTestBackupRestoreAction(t, manager)
+
+		diags, progress := invokeTestAction(t, a, restore("MGR01.example.com", nil))
+		require.False(t, diags.HasError(), "%v", diags)
+		require.True(t, manager.restored)
+		require.Equal(t, "correct horse battery staple", manager.passphrase)
+		require.Equal(t, "pexip_backup.tar.gpg", manager.filename)
+		require.Equal(t, archive, manager.archive)
+		require.Equal(t, []string{
+			fmt.Sprintf("Uploading backup %s (%d bytes) to mgr01.example.com", backup, len(archive)),
+			"Uploaded backup with SHA-256 " + hex.EncodeToString(sum[:]) + ", waiting for the Manager to come back",
+			"Manager is restarting",
+			"Restored backup to mgr01.example.com",
+		}, progress)
+	})
+
+	t.Run("no restart", func(t *testing.T) {
+		manager := &restoreManager{t: t}
+		a := newTestBackupRestoreAction(t, manager)
+		a.restartGrace = 10 * time.Millisecond
+
+		diags, progress := invokeTestAction(t, a, restore("mgr01", nil))
+		require.False(t, diags.HasError(), "%v", diags)
+		require.True(t, manager.restored)
+		require.Equal(t, "Restored backup to mgr01.example.com", progress[len(progress)-1])
+	})
+
+	t.Run("hostname mismatch", func(t *testing.T) {
+		manager := &restoreManager{t: t}
+		a := newTestBackupRestoreAction(t, manager)
+
+		diags, _ := invokeTestAction(t, a, restore("mgr02.example.com", nil))
+		require.True(t, diags.HasError())
+		require.Equal(t, `confirm_hostname is "mgr02.example.com", but the provider is connected to the Manager mgr01.example.com. The backup was not restored.`, diags.Errors()[0].Detail())
+		require.False(t, manager.restored)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		a := newTestBackupRestoreAction(t, &restoreManager{t: t, rejectWith: "Incorrect passphrase"})
+
+		diags, _ := invokeTestAction(t, a, restore("mgr01.example.com", nil))
+		require.True(t, diags.HasError())
+		require.Equal(t, "Could not restore backup: POST /api/admin/command/v1/backup/restore/ failed with status 400: Incorrect passphrase", diags.Errors()[0].Detail())
+	})
+
+	t.Run("missing archive", func(t *testing.T) {
+		manager := &restoreManager{t: t}
+		a := newTestBackupRestoreAction(t, manager)
+
+		diags, _ := invokeTestAction(t, a, restore("mgr01.example.com", map[string]tftypes.Value{
+			"path": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing.tar.gpg")),
+		}))
+		require.True(t, diags.HasError())
+		require.Equal(t, "Error Reading Backup", diags.Errors()[0].Summary())
+		require.False(t, manager.restored)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		a := newTestBackupRestoreAction(t, &restoreManager{t: t, downPolls: -1})
+
+		diags, _ := invokeTestAction(t, a, restore("mgr01.example.com", map[string]tftypes.Value{
+			"timeout": tftypes.NewValue(tftypes.String, "50ms"),
+		}))
+		require.True(t, diags.HasError())
+		require.Equal(t, "Infinity Backup Restore Timed Out", diags.Errors()[0].Summary())
+		require.Contains(t, diags.Errors()[0].Detail(), "Last error:")
+	})
+}
+
+func TestHostnameMatches(t *testing.T) {
+	manager := &config.ManagementVM{Hostname: "mgr01", Domain: "example.com"}
+
+	for hostname, want := range map[string]bool{
+		"mgr01":              true,
+		"MGR01":              true,
+		"mgr01.example.com":  true,
+		"mgr01.example.com.": true,
+		"mgr01.example.org":  false,
+		"mgr02":              false,
+		"":                   false,
+	} {
+		require.Equal(t, want, hostnameMatches(manager, hostname), hostname)
+	}
+	require.False(t, hostnameMatches(&config.ManagementVM{}, ""))
+}
+
+func TestInfinityBackupRestoreAction_PassphraseNotLogged(t *testing.T) {
+	manager := &restoreManager{t: t}
+	server := httptest.NewServer(manager)
+	t.Cleanup(server.Close)
+
+	// The passphrase cannot be marked as sensitive, so the multipart form it is
+	// sent in must not be logged at all
+	httpClient := &http.Client{Transport: &log.LoggingTransport{Base: http.DefaultTransport}}
+	files, err := newFileClient(server.URL, httpClient, nil, "")
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	fields := map[string]string{"passphrase": "correct horse battery staple"}
+	var result command.CommandResponse
+	require.NoError(t, files.upload(ctx, "command/v1/backup/restore/", fields, "package", "pexip_backup.tar.gpg", strings.NewReader("encrypted backup"), &result))
+
+	require.Equal(t, "correct horse battery staple", manager.passphrase)
+	require.Contains(t, output.String(), "body not logged")
+	require.NotContains(t, output.String(), "correct horse")
+	require.NotContains(t, output.String(), "encrypted backup")
+}
@@ -0,0 +1,213 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+const testBackupDownloadURI = "/api/admin/configuration/v1/system_backup/pexip_backup_10-0-0-10_38_0_2025-06-01T08-30-00.tar.gpg/"
+
+// backupManager stands in for the Manager's backup API. A backup request is
+// in progress for the first polls, and then complete with a download URI.
+type backupManager struct {
+	t        *testing.T
+	archive  []byte
+	failWith string
+
+	mu         sync.Mutex
+	created    bool
+	polls      int
+	passphrase string
+	// contentLength overrides the Content-Length of the download
+	contentLength int
+}
+
+func (m *backupManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/admin/status/v1/backup_request/":
+		list := status.BackupRequestListResponse{Objects: []status.BackupRequest{
+			{ResourceURI: "/api/admin/status/v1/backup_request/1/", State: "COMPLETE", DownloadURI: "/old-backup/"},
+		}}
+		if m.created {
+			m.polls++
+			request := status.BackupRequest{ResourceURI: "/api/admin/status/v1/backup_request/2/", State: "IN_PROGRESS"}
+			switch {
+			case m.polls > 1 && m.failWith != "":
+				request.State = "FAILED"
+				request.Message = m.failWith
+			case m.polls > 1:
+				request.State = "COMPLETE"
+				request.DownloadURI = testBackupDownloadURI
+			}
+			list.Objects = append(list.Objects, request)
+		}
+		require.NoError(m.t, json.NewEncoder(w).Encode(list))
+	case r.Method == http.MethodPost && r.URL.Path == "/api/admin/command/v1/backup/create/":
+		var body command.BackupCreateRequest
+		require.NoError(m.t, json.NewDecoder(r.Body).Decode(&body))
+		require.True(m.t, body.Request)
+		m.passphrase = body.Passphrase
+		m.created = true
+		require.NoError(m.t, json.NewEncoder(w).Encode(command.CommandResponse{Status: "success"}))
+	case r.Method == http.MethodGet && r.URL.Path == testBackupDownloadURI:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if m.contentLength > 0 {
+			w.Header().Set("Content-Length", strconv.Itoa(m.contentLength))
+		}
+		_, _ = w.Write(m.archive)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestBackupAction(t *testing.T, manager http.Handler) *InfinityBackupAction {
+	server := httptest.NewServer(manager)
+	t.Cleanup(server.Close)
+
+	client, err := infinity.New(infinity.WithBaseURL(server.URL), infinity.WithBasicAuth("admin", "admin"), infinity.WithNoRetries())
+	require.NoError(t, err)
+	files, err := newFileClient(server.URL, server.Client(), nil, "")
+	require.NoError(t, err)
+	return &InfinityBackupAction{InfinityClient: client, files: files, pollInterval: time.Millisecond}
+}
+
+func TestInfinityBackupAction(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	client.On("GetJSON", mock.Anything, "status/v1/backup_request/", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	testInfinityBackupAction(t, client)
+}
+
+func testInfinityBackupAction(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				// The test provider has no client for file transfers
+				Config:      test.LoadTestFolder(t, "action_infinity_backup_basic"),
+				ExpectError: regexp.MustCompile("File Transfers Not Configured"),
+			},
+		},
+	})
+}
+
+func TestInfinityBackupAction_Invoke(t *testing.T) {
+	archive := []byte("-----BEGIN PGP MESSAGE-----\nencrypted backup\n-----END PGP MESSAGE-----\n")
+	sum := sha256.Sum256(archive)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("file", func(t *testing.T) {
+		manager := &backupManager{t: t, archive: archive}
+		a := newTestBackupAction(t, manager)
+		target := filepath.Join(t.TempDir(), "before-upgrade.tar.gpg")
+
+		diags, progress := invokeTestAction(t, a, map[string]tftypes.Value{
+			"passphrase": tftypes.NewValue(tftypes.String, "correct horse battery staple"),
+			"path":       tftypes.NewValue(tftypes.String, target),
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+		require.Equal(t, "correct horse battery staple", manager.passphrase)
+
+		written, err := os.ReadFile(target)
+		require.NoError(t, err)
+		require.Equal(t, archive, written)
+		require.Equal(t, []string{
+			"Creating backup",
+			"Backup is IN_PROGRESS",
+			"Backup is COMPLETE",
+			"Downloading backup to " + target,
+			fmt.Sprintf("Downloaded backup to %s: %d bytes, SHA-256 %s", target, len(archive), checksum),
+		}, progress)
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		a := newTestBackupAction(t, &backupManager{t: t, archive: archive})
+		dir := t.TempDir()
+
+		diags, _ := invokeTestAction(t, a, map[string]tftypes.Value{
+			"passphrase": tftypes.NewValue(tftypes.String, "secret"),
+			"path":       tftypes.NewValue(tftypes.String, dir),
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "pexip_backup_10-0-0-10_38_0_2025-06-01T08-30-00.tar.gpg", entries[0].Name())
+	})
+
+	t.Run("backup failed", func(t *testing.T) {
+		a := newTestBackupAction(t, &backupManager{t: t, archive: archive, failWith: "Disk full"})
+
+		diags, _ := invokeTestAction(t, a, map[string]tftypes.Value{
+			"passphrase": tftypes.NewValue(tftypes.String, "secret"),
+			"path":       tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "backup.tar.gpg")),
+		})
+		require.True(t, diags.HasError())
+		require.Equal(t, "Could not create backup: backup FAILED: Disk full", diags.Errors()[0].Detail())
+	})
+
+	t.Run("incomplete download", func(t *testing.T) {
+		a := newTestBackupAction(t, &backupManager{t: t, archive: archive, contentLength: len(archive) + 100})
+		dir := t.TempDir()
+
+		diags, _ := invokeTestAction(t, a, map[string]tftypes.Value{
+			"passphrase": tftypes.NewValue(tftypes.String, "secret"),
+			"path":       tftypes.NewValue(tftypes.String, filepath.Join(dir, "backup.tar.gpg")),
+		})
+		require.True(t, diags.HasError())
+		require.Equal(t, "Error Downloading Infinity backup", diags.Errors()[0].Summary())
+
+		// Nothing is left behind
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		a := newTestBackupAction(t, &backupManager{t: t, archive: archive})
+		a.pollInterval = time.Hour
+
+		diags, _ := invokeTestAction(t, a, map[string]tftypes.Value{
+			"passphrase": tftypes.NewValue(tftypes.String, "secret"),
+			"path":       tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "backup.tar.gpg")),
+			"timeout":    tftypes.NewValue(tftypes.String, "50ms"),
+		})
+		require.True(t, diags.HasError())
+		require.Equal(t, "Infinity Backup Timed Out", diags.Errors()[0].Summary())
+	})
+}
@@ -52,6 +52,10 @@ func (a *InfinityDeleteDefaultMgrTLSCertificateAction) Schema(ctx context.Contex
 }
 
 func (a *InfinityDeleteDefaultMgrTLSCertificateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if checkReadOnly(a.InfinityClient, "delete the default Manager TLS certificate", &resp.Diagnostics) {
+		return
+	}
+
 	// Delete the default TLS certificate (ID 1)
 	const defaultCertificateID = 1
 
@@ -0,0 +1,175 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = (*InfinityHealthCheckAction)(nil)
+	_ action.ActionWithConfigure = (*InfinityHealthCheckAction)(nil)
+)
+
+// syncStatusSynced is the sync_status of a node that has the latest
+// configuration from the Manager.
+const syncStatusSynced = "SYNCED"
+
+type InfinityHealthCheckAction struct {
+	InfinityClient InfinityClient
+}
+
+type InfinityHealthCheckActionModel struct {
+	AlarmLevels              types.List `tfsdk:"alarm_levels"`
+	IgnoreAlarms             types.List `tfsdk:"ignore_alarms"`
+	IgnoreAcknowledgedAlarms types.Bool `tfsdk:"ignore_acknowledged_alarms"`
+	CheckSync                types.Bool `tfsdk:"check_sync"`
+}
+
+func (a *InfinityHealthCheckAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_health_check"
+}
+
+func (a *InfinityHealthCheckAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+
+	a.InfinityClient = p.client
+}
+
+func (a *InfinityHealthCheckAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alarm_levels": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Severities of the active alarms that fail the check, e.g. `[\"critical\", \"error\"]`. Case insensitive. Defaults to `[\"critical\"]`.",
+			},
+			"ignore_alarms": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of alarms that never fail the check, e.g. alarms that are expected in a lab.",
+			},
+			"ignore_acknowledged_alarms": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Do not fail the check for alarms that an administrator has acknowledged. Defaults to `false`.",
+			},
+			"check_sync": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Fail the check if a Management or Conferencing Node does not have the latest configuration. Defaults to `true`.",
+			},
+		},
+		MarkdownDescription: "Checks the health of the Infinity deployment after an apply, and fails if there are critical alarms or nodes that are out of sync. Use it with an `action_trigger` so that problems such as a bad certificate or a failed node registration fail the run, rather than only showing up in the administrator interface.",
+	}
+}
+
+func (a *InfinityHealthCheckAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InfinityHealthCheckActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarmLevels := []string{"critical"}
+	if !data.AlarmLevels.IsNull() {
+		resp.Diagnostics.Append(data.AlarmLevels.ElementsAs(ctx, &alarmLevels, false)...)
+	}
+	var ignoreAlarms []string
+	if !data.IgnoreAlarms.IsNull() {
+		resp.Diagnostics.Append(data.IgnoreAlarms.ElementsAs(ctx, &ignoreAlarms, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ignoreAcknowledged := data.IgnoreAcknowledgedAlarms.ValueBool()
+	checkSync := data.CheckSync.IsNull() || data.CheckSync.ValueBool()
+
+	alarms, err := listAlarms(ctx, a.InfinityClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity alarms",
+			fmt.Sprintf("Could not list alarms: %s", err),
+		)
+		return
+	}
+
+	var problems []string
+	for _, alarm := range alarms {
+		if !slices.ContainsFunc(alarmLevels, func(level string) bool { return strings.EqualFold(level, alarm.Level) }) ||
+			slices.Contains(ignoreAlarms, alarm.Name) ||
+			(ignoreAcknowledged && alarm.Acknowledged) {
+			continue
+		}
+		problems = append(problems, fmt.Sprintf("%s alarm %q on %s: %s", alarm.Level, alarm.Name, alarm.Node, alarm.Details))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Checked %d active alarms", len(alarms)))
+
+	nodes := 0
+	if checkSync {
+		managementVMs, err := listManagementVMStatuses(ctx, a.InfinityClient)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Infinity management VM status",
+				fmt.Sprintf("Could not list management VM statuses: %s", err),
+			)
+			return
+		}
+		for _, vm := range managementVMs {
+			if vm.SyncStatus != syncStatusSynced {
+				problems = append(problems, fmt.Sprintf("Management Node %s is not in sync: %s", vm.Name, vm.SyncStatus))
+			}
+		}
+
+		workerVMs, err := listWorkerVMStatuses(ctx, a.InfinityClient)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Infinity worker VM status",
+				fmt.Sprintf("Could not list worker VM statuses: %s", err),
+			)
+			return
+		}
+		for _, vm := range workerVMs {
+			if vm.SyncStatus != syncStatusSynced {
+				problems = append(problems, fmt.Sprintf("Conferencing Node %s is not in sync: %s", vm.Name, vm.SyncStatus))
+			}
+		}
+		nodes = len(managementVMs) + len(workerVMs)
+		tflog.Debug(ctx, fmt.Sprintf("Checked the sync status of %d nodes", nodes))
+	}
+
+	if len(problems) > 0 {
+		resp.Diagnostics.AddError(
+			"Infinity Health Check Failed",
+			fmt.Sprintf("Found %d problems:\n- %s", len(problems), strings.Join(problems, "\n- ")),
+		)
+		return
+	}
+
+	message := fmt.Sprintf("Infinity is healthy: checked %d active alarms", len(alarms))
+	if checkSync {
+		message += fmt.Sprintf(", all %d nodes are in sync", nodes)
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+}
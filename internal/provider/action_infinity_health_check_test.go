@@ -0,0 +1,131 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+func mockNodeSyncStatus(client *infinity.ClientMock, workerSyncStatus string) {
+	client.On("GetJSON", mock.Anything, "status/v1/management_vm/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		vms := args.Get(3).(*status.ManagementVMListResponse)
+		vms.Objects = []status.ManagementVM{{Name: "manager", Primary: true, SyncStatus: "SYNCED"}}
+	}).Maybe()
+	client.On("GetJSON", mock.Anything, "status/v1/worker_vm/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		vms := args.Get(3).(*status.WorkerVMListResponse)
+		vms.Objects = []status.WorkerVM{
+			{Name: "worker-1", SyncStatus: "SYNCED"},
+			{Name: "worker-2", SyncStatus: workerSyncStatus},
+		}
+	}).Maybe()
+}
+
+func TestInfinityHealthCheckAction(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockAlarms(client, testAlarms[1])
+	mockNodeSyncStatus(client, "SYNCED")
+
+	testInfinityHealthCheckAction(t, client)
+}
+
+func testInfinityHealthCheckAction(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "action_infinity_health_check_basic"),
+			},
+		},
+	})
+}
+
+func TestInfinityHealthCheckAction_Invoke(t *testing.T) {
+	stringList := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+
+	tests := []struct {
+		name             string
+		alarms           []status.Alarm
+		workerSyncStatus string
+		attrs            map[string]tftypes.Value
+		problems         []string
+	}{
+		{
+			name:             "healthy",
+			alarms:           testAlarms[1:],
+			workerSyncStatus: "SYNCED",
+		},
+		{
+			name:             "critical alarm",
+			alarms:           testAlarms,
+			workerSyncStatus: "SYNCED",
+			problems:         []string{`critical alarm "Certificate expired" on 10.0.0.10`},
+		},
+		{
+			name:             "error alarm and node out of sync",
+			alarms:           testAlarms[1:],
+			workerSyncStatus: "SYNCING",
+			attrs:            map[string]tftypes.Value{"alarm_levels": stringList("critical", "Error")},
+			problems: []string{
+				`error alarm "Failed to register" on 10.0.0.11`,
+				"Conferencing Node worker-2 is not in sync: SYNCING",
+			},
+		},
+		{
+			name:             "ignored alarms and sync",
+			alarms:           testAlarms,
+			workerSyncStatus: "SYNCING",
+			attrs: map[string]tftypes.Value{
+				"alarm_levels":               stringList("critical", "warning"),
+				"ignore_alarms":              stringList("Certificate expired"),
+				"ignore_acknowledged_alarms": tftypes.NewValue(tftypes.Bool, true),
+				"check_sync":                 tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := infinity.NewClientMock()
+			mockAlarms(client, tt.alarms...)
+			mockNodeSyncStatus(client, tt.workerSyncStatus)
+
+			diags, progress := invokeTestAction(t, &InfinityHealthCheckAction{InfinityClient: client}, tt.attrs)
+			if len(tt.problems) == 0 {
+				require.False(t, diags.HasError(), "%v", diags)
+				require.Len(t, progress, 1)
+				require.Contains(t, progress[0], "Infinity is healthy")
+				return
+			}
+			require.Len(t, diags.Errors(), 1)
+			require.Equal(t, "Infinity Health Check Failed", diags.Errors()[0].Summary())
+			for _, problem := range tt.problems {
+				require.Contains(t, diags.Errors()[0].Detail(), problem)
+			}
+			require.Empty(t, progress)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pexip/go-infinity-sdk/v38/status"
+
+	"github.com/pexip/terraform-provider-pexip/internal/provider/validators"
+)
+
+var (
+	_ action.Action              = (*InfinityLDAPSyncAction)(nil)
+	_ action.ActionWithConfigure = (*InfinityLDAPSyncAction)(nil)
+)
+
+const (
+	defaultLDAPSyncTimeout      = 30 * time.Minute
+	defaultLDAPSyncPollInterval = 5 * time.Second
+)
+
+type InfinityLDAPSyncAction struct {
+	InfinityClient InfinityClient
+
+	pollInterval time.Duration
+}
+
+type InfinityLDAPSyncActionModel struct {
+	TemplateID types.Int64  `tfsdk:"template_id"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+func (a *InfinityLDAPSyncAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_ldap_sync"
+}
+
+func (a *InfinityLDAPSyncAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+
+	a.InfinityClient = p.client
+}
+
+func (a *InfinityLDAPSyncAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"template_id": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				MarkdownDescription: "The `resource_id` of the conference sync template to run. If not set, every template is synchronised.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "How long to wait for the sync to finish, e.g. `1h`. Defaults to `30m`.",
+			},
+		},
+		MarkdownDescription: "Starts an LDAP sync of the VMRs, devices and users that the conference sync templates create from the `pexip_infinity_ldap_sync_source`, and waits for it to finish. Fails if the sync reports errors.",
+	}
+}
+
+func (a *InfinityLDAPSyncAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InfinityLDAPSyncActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if checkReadOnly(a.InfinityClient, "start an LDAP sync", &resp.Diagnostics) {
+		return
+	}
+
+	timeout := defaultLDAPSyncTimeout
+	if !data.Timeout.IsNull() {
+		// The value has been checked by the validator
+		timeout, _ = time.ParseDuration(data.Timeout.ValueString())
+	}
+	pollInterval := a.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultLDAPSyncPollInterval
+	}
+	templateID := ""
+	if !data.TemplateID.IsNull() {
+		templateID = strconv.FormatInt(data.TemplateID.ValueInt64(), 10)
+	}
+	isSelected := func(sync status.ConferenceSync) bool {
+		return templateID == "" || strconv.Itoa(sync.ConfigurationID) == templateID
+	}
+
+	// Remember when each template last finished, so that the result of an
+	// earlier sync is not mistaken for the result of this one
+	before, err := a.listConferenceSyncs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity LDAP sync status",
+			fmt.Sprintf("Could not list conference sync statuses: %s", err),
+		)
+		return
+	}
+	lastUpdated := make(map[int]string)
+	for _, sync := range before {
+		lastUpdated[sync.ID] = timeValue(sync.LastUpdated).ValueString()
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Starting LDAP sync of template %q", templateID))
+	if _, err := a.InfinityClient.Command().Sync(ctx, templateID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Starting Infinity LDAP sync",
+			fmt.Sprintf("Could not start LDAP sync: %s", err),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Started LDAP sync"})
+
+	var finished []status.ConferenceSync
+	progress := make(map[int]string)
+	err = pollUntil(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		syncs, err := a.listConferenceSyncs(ctx)
+		if err != nil {
+			return false, err
+		}
+		finished = finished[:0]
+		done := true
+		selected := 0
+		for _, sync := range syncs {
+			if !isSelected(sync) {
+				continue
+			}
+			selected++
+			if message := ldapSyncProgress(sync); message != progress[sync.ID] {
+				progress[sync.ID] = message
+				resp.SendProgress(action.InvokeProgressEvent{Message: message})
+			}
+			previous, seen := lastUpdated[sync.ID]
+			started := !seen || timeValue(sync.LastUpdated).ValueString() != previous
+			if !started || ldapSyncRunning(sync) {
+				done = false
+				continue
+			}
+			finished = append(finished, sync)
+		}
+		return done && selected > 0, nil
+	})
+	if errors.Is(err, errPollTimeout) {
+		resp.Diagnostics.AddError(
+			"Infinity LDAP Sync Timed Out",
+			fmt.Sprintf("The LDAP sync did not finish within %s. It may still be running on the Manager.", timeout),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity LDAP sync status",
+			fmt.Sprintf("Could not list conference sync statuses: %s", err),
+		)
+		return
+	}
+
+	var problems []string
+	for _, sync := range finished {
+		if sync.SyncErrors > 0 {
+			problems = append(problems, fmt.Sprintf("template %d: %d errors, last error: %s", sync.ConfigurationID, sync.SyncErrors, sync.SyncLastErrorDescription))
+		}
+	}
+	if len(problems) > 0 {
+		resp.Diagnostics.AddError(
+			"Infinity LDAP Sync Failed",
+			fmt.Sprintf("The LDAP sync finished with errors:\n- %s", strings.Join(problems, "\n- ")),
+		)
+		return
+	}
+
+	for _, sync := range finished {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf(
+				"LDAP sync of template %d finished: VMRs %d created, %d updated, %d deleted; devices %d created, %d updated, %d deleted; users %d created, %d updated, %d deleted",
+				sync.ConfigurationID,
+				sync.VMRsCreated, sync.VMRsUpdated, sync.VMRsDeleted,
+				sync.DevicesCreated, sync.DevicesUpdated, sync.DevicesDeleted,
+				sync.EndUsersCreated, sync.EndUsersUpdated, sync.EndUsersDeleted,
+			),
+		})
+	}
+}
+
+func (a *InfinityLDAPSyncAction) listConferenceSyncs(ctx context.Context) ([]status.ConferenceSync, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]status.ConferenceSync, string, error) {
+		page, err := a.InfinityClient.Status().ListConferenceSyncs(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+}
+
+// ldapSyncRunning reports whether the sync of a conference sync template is
+// still in progress.
+func ldapSyncRunning(sync status.ConferenceSync) bool {
+	syncStatus := strings.ToLower(sync.SyncStatus)
+	for _, running := range []string{"progress", "syncing", "running", "pending", "queued"} {
+		if strings.Contains(syncStatus, running) {
+			return true
+		}
+	}
+	return false
+}
+
+func ldapSyncProgress(sync status.ConferenceSync) string {
+	return fmt.Sprintf("LDAP sync of template %d: %s, %d%% complete", sync.ConfigurationID, sync.SyncStatus, sync.SyncProgress)
+}
@@ -0,0 +1,147 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/pexip/go-infinity-sdk/v38/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+// mockLDAPSync returns the conference sync statuses in order, one list per
+// request, repeating the last one.
+func mockLDAPSync(client *infinity.ClientMock, statuses ...[]status.ConferenceSync) {
+	var mu sync.Mutex
+	calls := 0
+	client.On("GetJSON", mock.Anything, "status/v1/conference_sync/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		list := args.Get(3).(*status.ConferenceSyncListResponse)
+		list.Objects = statuses[min(calls, len(statuses)-1)]
+		calls++
+	}).Maybe()
+	client.On("PostJSON", mock.Anything, "command/v1/sync/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		response := args.Get(3).(*command.CommandResponse)
+		response.Status = "success"
+	}).Maybe()
+}
+
+func testConferenceSync(updated int, syncStatus string, progress, syncErrors int) status.ConferenceSync {
+	return status.ConferenceSync{
+		ID:                       1,
+		ConfigurationID:          3,
+		LastUpdated:              &util.InfinityTime{Time: time.Date(2025, 6, 1, 8, updated, 0, 0, time.UTC)},
+		SyncStatus:               syncStatus,
+		SyncProgress:             progress,
+		SyncErrors:               syncErrors,
+		SyncLastErrorDescription: "LDAP server unreachable",
+		VMRsCreated:              2,
+		VMRsUpdated:              1,
+		ResourceURI:              "/api/admin/status/v1/conference_sync/1/",
+	}
+}
+
+func TestInfinityLDAPSyncAction(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockLDAPSync(client,
+		[]status.ConferenceSync{testConferenceSync(0, "Completed", 100, 0)},
+		[]status.ConferenceSync{testConferenceSync(5, "Completed", 100, 0)},
+	)
+
+	testInfinityLDAPSyncAction(t, client)
+}
+
+func testInfinityLDAPSyncAction(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "action_infinity_ldap_sync_basic"),
+			},
+		},
+	})
+}
+
+func TestInfinityLDAPSyncAction_Invoke(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses [][]status.ConferenceSync
+		attrs    map[string]tftypes.Value
+		wantErr  string
+		progress []string
+	}{
+		{
+			name: "success",
+			statuses: [][]status.ConferenceSync{
+				{testConferenceSync(0, "Completed", 100, 0)},
+				{testConferenceSync(0, "Completed", 100, 0)},
+				{testConferenceSync(1, "In progress", 50, 0)},
+				{testConferenceSync(2, "Completed", 100, 0)},
+			},
+			progress: []string{
+				"Started LDAP sync",
+				"LDAP sync of template 3: Completed, 100% complete",
+				"LDAP sync of template 3: In progress, 50% complete",
+				"LDAP sync of template 3: Completed, 100% complete",
+				"LDAP sync of template 3 finished: VMRs 2 created, 1 updated, 0 deleted; devices 0 created, 0 updated, 0 deleted; users 0 created, 0 updated, 0 deleted",
+			},
+		},
+		{
+			name: "sync errors",
+			statuses: [][]status.ConferenceSync{
+				{testConferenceSync(0, "Completed", 100, 0)},
+				{testConferenceSync(1, "Failed", 100, 4)},
+			},
+			wantErr: "The LDAP sync finished with errors:\n- template 3: 4 errors, last error: LDAP server unreachable",
+		},
+		{
+			name: "other template",
+			statuses: [][]status.ConferenceSync{
+				{testConferenceSync(0, "Completed", 100, 0)},
+			},
+			attrs: map[string]tftypes.Value{
+				"template_id": tftypes.NewValue(tftypes.Number, 4),
+				"timeout":     tftypes.NewValue(tftypes.String, "50ms"),
+			},
+			wantErr: "The LDAP sync did not finish within 50ms. It may still be running on the Manager.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := infinity.NewClientMock()
+			mockLDAPSync(client, tt.statuses...)
+			a := &InfinityLDAPSyncAction{InfinityClient: client, pollInterval: time.Millisecond}
+
+			diags, progress := invokeTestAction(t, a, tt.attrs)
+			if tt.wantErr != "" {
+				require.True(t, diags.HasError())
+				require.Equal(t, tt.wantErr, diags.Errors()[0].Detail())
+				return
+			}
+			require.False(t, diags.HasError(), "%v", diags)
+			require.Equal(t, tt.progress, progress)
+			client.AssertCalled(t, "PostJSON", mock.Anything, "command/v1/sync/", &command.SyncRequest{}, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pexip/go-infinity-sdk/v38/command"
+
+	"github.com/pexip/terraform-provider-pexip/internal/provider/validators"
+)
+
+var (
+	_ action.Action              = (*InfinitySendProvisioningEmailAction)(nil)
+	_ action.ActionWithConfigure = (*InfinitySendProvisioningEmailAction)(nil)
+)
+
+const defaultProvisioningEmailBatchSize = 10
+
+type InfinitySendProvisioningEmailAction struct {
+	InfinityClient InfinityClient
+}
+
+type InfinitySendProvisioningEmailActionModel struct {
+	ConferenceIDs            types.List   `tfsdk:"conference_ids"`
+	DeviceIDs                types.List   `tfsdk:"device_ids"`
+	ConferenceSyncTemplateID types.Int64  `tfsdk:"conference_sync_template_id"`
+	BatchSize                types.Int64  `tfsdk:"batch_size"`
+	BatchInterval            types.String `tfsdk:"batch_interval"`
+}
+
+// provisioningEmail is a VMR or device to send a provisioning email for.
+type provisioningEmail struct {
+	kind string
+	id   int
+	err  error
+}
+
+func (e provisioningEmail) String() string {
+	return fmt.Sprintf("%s %d", e.kind, e.id)
+}
+
+func (a *InfinitySendProvisioningEmailAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_send_provisioning_email"
+}
+
+func (a *InfinitySendProvisioningEmailAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+
+	a.InfinityClient = p.client
+}
+
+func (a *InfinitySendProvisioningEmailAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"conference_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.AtLeastOneOf(path.MatchRoot("device_ids")),
+					listvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+				MarkdownDescription: "The `resource_id`s of the VMRs to send provisioning emails for.",
+			},
+			"device_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+				MarkdownDescription: "The `resource_id`s of the devices to send provisioning emails for.",
+			},
+			"conference_sync_template_id": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				MarkdownDescription: "The `resource_id` of the conference sync template whose email templates are used. If not set, the default email templates are used.",
+			},
+			"batch_size": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+				MarkdownDescription: "The number of emails sent at the same time. Defaults to `10`.",
+			},
+			"batch_interval": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "How long to wait between batches, e.g. `5s`, to stay within the rate limits of the SMTP server. If not set, batches are sent one after the other.",
+			},
+		},
+		MarkdownDescription: "Sends provisioning emails to the owners of VMRs and devices, e.g. after creating them with `primary_owner_email_address` set. The emails are sent through the `pexip_infinity_smtp_server`, in batches, and the result for each VMR and device is reported.",
+	}
+}
+
+func (a *InfinitySendProvisioningEmailAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InfinitySendProvisioningEmailActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var conferenceIDs, deviceIDs []int64
+	if !data.ConferenceIDs.IsNull() {
+		resp.Diagnostics.Append(data.ConferenceIDs.ElementsAs(ctx, &conferenceIDs, false)...)
+	}
+	if !data.DeviceIDs.IsNull() {
+		resp.Diagnostics.Append(data.DeviceIDs.ElementsAs(ctx, &deviceIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if checkReadOnly(a.InfinityClient, "send provisioning emails", &resp.Diagnostics) {
+		return
+	}
+
+	batchSize := defaultProvisioningEmailBatchSize
+	if !data.BatchSize.IsNull() {
+		batchSize = int(data.BatchSize.ValueInt64())
+	}
+	var batchInterval time.Duration
+	if !data.BatchInterval.IsNull() {
+		// The value has been checked by the validator
+		batchInterval, _ = time.ParseDuration(data.BatchInterval.ValueString())
+	}
+	var templateID *int
+	if !data.ConferenceSyncTemplateID.IsNull() {
+		id := int(data.ConferenceSyncTemplateID.ValueInt64())
+		templateID = &id
+	}
+
+	emails := make([]provisioningEmail, 0, len(conferenceIDs)+len(deviceIDs))
+	for _, id := range conferenceIDs {
+		emails = append(emails, provisioningEmail{kind: "VMR", id: int(id)})
+	}
+	for _, id := range deviceIDs {
+		emails = append(emails, provisioningEmail{kind: "device", id: int(id)})
+	}
+
+	for start := 0; start < len(emails); start += batchSize {
+		if start > 0 && batchInterval > 0 {
+			select {
+			case <-ctx.Done():
+				resp.Diagnostics.AddError(
+					"Error Sending Infinity provisioning emails",
+					fmt.Sprintf("Stopped after %d of %d provisioning emails: %s", start, len(emails), ctx.Err()),
+				)
+				return
+			case <-time.After(batchInterval):
+			}
+		}
+
+		batch := emails[start:min(start+batchSize, len(emails))]
+		var wg sync.WaitGroup
+		for i := range batch {
+			wg.Add(1)
+			go func(email *provisioningEmail) {
+				defer wg.Done()
+				email.err = a.send(ctx, *email, templateID)
+			}(&batch[i])
+		}
+		wg.Wait()
+
+		for _, email := range batch {
+			if email.err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Could not send provisioning email for %s: %s", email, email.err))
+				resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Failed to send provisioning email for %s: %s", email, email.err)})
+				continue
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Sent provisioning email for %s", email)})
+		}
+	}
+
+	var failed []string
+	for _, email := range emails {
+		if email.err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", email, email.err))
+		}
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sent %d of %d provisioning emails", len(emails)-len(failed), len(emails)),
+	})
+	if len(failed) > 0 {
+		resp.Diagnostics.AddError(
+			"Error Sending Infinity provisioning emails",
+			fmt.Sprintf("Could not send %d of %d provisioning emails:\n- %s", len(failed), len(emails), strings.Join(failed, "\n- ")),
+		)
+	}
+}
+
+func (a *InfinitySendProvisioningEmailAction) send(ctx context.Context, email provisioningEmail, templateID *int) error {
+	var result *command.CommandResponse
+	var err error
+	switch email.kind {
+	case "VMR":
+		result, err = a.InfinityClient.Command().SendConferenceEmail(ctx, email.id, templateID)
+	default:
+		result, err = a.InfinityClient.Command().SendDeviceEmail(ctx, email.id, templateID)
+	}
+	if err != nil {
+		return err
+	}
+	if result.Status != "" && !strings.EqualFold(result.Status, "success") {
+		return fmt.Errorf("%s: %s", result.Status, result.Message)
+	}
+	return nil
+}
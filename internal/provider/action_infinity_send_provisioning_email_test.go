@@ -0,0 +1,133 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+// mockProvisioningEmails records the provisioning emails sent. Emails for the
+// VMRs and devices in noOwner fail, as if they had no owner email address.
+func mockProvisioningEmails(client *infinity.ClientMock, noOwner ...int) *[]any {
+	var mu sync.Mutex
+	var sent []any
+	respond := func(id int, args mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, args.Get(2))
+		response := args.Get(3).(*command.CommandResponse)
+		response.Status = "success"
+		for _, noOwnerID := range noOwner {
+			if id == noOwnerID {
+				response.Status = "failure"
+				response.Message = "No primary owner email address"
+			}
+		}
+	}
+	client.On("PostJSON", mock.Anything, "command/v1/conference/send_email/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		respond(args.Get(2).(*command.ConferenceSendEmailRequest).ConferenceID, args)
+	}).Maybe()
+	client.On("PostJSON", mock.Anything, "command/v1/device/send_email/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		respond(args.Get(2).(*command.DeviceSendEmailRequest).DeviceID, args)
+	}).Maybe()
+	return &sent
+}
+
+func TestInfinitySendProvisioningEmailAction(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockProvisioningEmails(client)
+
+	testInfinitySendProvisioningEmailAction(t, client)
+}
+
+func testInfinitySendProvisioningEmailAction(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "action_infinity_send_provisioning_email_basic"),
+			},
+		},
+	})
+}
+
+func TestInfinitySendProvisioningEmailAction_Invoke(t *testing.T) {
+	ids := func(values ...int) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.Number, value))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, elements)
+	}
+
+	t.Run("batches", func(t *testing.T) {
+		client := infinity.NewClientMock()
+		sent := mockProvisioningEmails(client)
+		a := &InfinitySendProvisioningEmailAction{InfinityClient: client}
+
+		diags, progress := invokeTestAction(t, a, map[string]tftypes.Value{
+			"conference_ids":              ids(1, 2, 3),
+			"device_ids":                  ids(7),
+			"conference_sync_template_id": tftypes.NewValue(tftypes.Number, 5),
+			"batch_size":                  tftypes.NewValue(tftypes.Number, 2),
+			"batch_interval":              tftypes.NewValue(tftypes.String, "1ms"),
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+		require.Equal(t, []string{
+			"Sent provisioning email for VMR 1",
+			"Sent provisioning email for VMR 2",
+			"Sent provisioning email for VMR 3",
+			"Sent provisioning email for device 7",
+			"Sent 4 of 4 provisioning emails",
+		}, progress)
+
+		templateID := 5
+		require.ElementsMatch(t, []any{
+			&command.ConferenceSendEmailRequest{ConferenceID: 1, ConferenceSyncTemplateID: &templateID},
+			&command.ConferenceSendEmailRequest{ConferenceID: 2, ConferenceSyncTemplateID: &templateID},
+			&command.ConferenceSendEmailRequest{ConferenceID: 3, ConferenceSyncTemplateID: &templateID},
+			&command.DeviceSendEmailRequest{DeviceID: 7, ConferenceSyncTemplateID: &templateID},
+		}, *sent)
+	})
+
+	t.Run("failures", func(t *testing.T) {
+		client := infinity.NewClientMock()
+		sent := mockProvisioningEmails(client, 2, 8)
+		a := &InfinitySendProvisioningEmailAction{InfinityClient: client}
+
+		diags, progress := invokeTestAction(t, a, map[string]tftypes.Value{
+			"conference_ids": ids(1, 2),
+			"device_ids":     ids(7, 8),
+		})
+		require.True(t, diags.HasError())
+		require.Equal(t, "Could not send 2 of 4 provisioning emails:\n- VMR 2: failure: No primary owner email address\n- device 8: failure: No primary owner email address", diags.Errors()[0].Detail())
+		require.Equal(t, []string{
+			"Sent provisioning email for VMR 1",
+			"Failed to send provisioning email for VMR 2: failure: No primary owner email address",
+			"Sent provisioning email for device 7",
+			"Failed to send provisioning email for device 8: failure: No primary owner email address",
+			"Sent 2 of 4 provisioning emails",
+		}, progress)
+		// The emails after a failure are still sent
+		require.Len(t, *sent, 4)
+	})
+}
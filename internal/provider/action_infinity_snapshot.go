@@ -0,0 +1,248 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+
+	"github.com/pexip/terraform-provider-pexip/internal/provider/validators"
+)
+
+var (
+	_ action.Action              = (*InfinitySnapshotAction)(nil)
+	_ action.ActionWithConfigure = (*InfinitySnapshotAction)(nil)
+)
+
+const (
+	// Snapshots of a large deployment can take a long time to generate
+	defaultSnapshotTimeout      = time.Hour
+	defaultSnapshotPollInterval = 10 * time.Second
+)
+
+type InfinitySnapshotAction struct {
+	InfinityClient InfinityClient
+
+	files        *fileClient
+	pollInterval time.Duration
+}
+
+type InfinitySnapshotActionModel struct {
+	Path                     types.String `tfsdk:"path"`
+	LimitHours               types.Int64  `tfsdk:"limit_hours"`
+	EndLimitHours            types.Int64  `tfsdk:"end_limit_hours"`
+	IncludeDiagnosticMetrics types.Bool   `tfsdk:"include_diagnostic_metrics"`
+	Timeout                  types.String `tfsdk:"timeout"`
+}
+
+func (a *InfinitySnapshotAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_snapshot"
+}
+
+func (a *InfinitySnapshotAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+
+	a.InfinityClient = p.client
+	a.files = p.files
+}
+
+func (a *InfinitySnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Local path to download the snapshot archive to. If it is an existing directory, the archive is saved in it under the name given by the Manager.",
+			},
+			"limit_hours": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				MarkdownDescription: "The number of hours of logs to include in the snapshot. If not set, the Manager's default is used.",
+			},
+			"end_limit_hours": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: "How many hours ago the time window of the snapshot ends. Together with `limit_hours` this selects an earlier window, e.g. the time of a failure. If not set, the window ends now.",
+			},
+			"include_diagnostic_metrics": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to include diagnostic metrics in the snapshot. If not set, the Manager's default is used.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "How long to wait for the Manager to generate the snapshot, e.g. `2h`. Defaults to `1h`.",
+			},
+		},
+		MarkdownDescription: "Generates a diagnostic snapshot of the platform and downloads it, e.g. to collect diagnostics for support from CI as soon as an apply fails.",
+	}
+}
+
+func (a *InfinitySnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InfinitySnapshotActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if checkReadOnly(a.InfinityClient, "create a snapshot", &resp.Diagnostics) {
+		return
+	}
+	if a.files == nil {
+		resp.Diagnostics.AddError(
+			"File Transfers Not Configured",
+			"The provider has no client for file transfers. Please report this issue to the provider developers",
+		)
+		return
+	}
+
+	timeout := defaultSnapshotTimeout
+	if !data.Timeout.IsNull() {
+		// The value has been checked by the validator
+		timeout, _ = time.ParseDuration(data.Timeout.ValueString())
+	}
+	pollInterval := a.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultSnapshotPollInterval
+	}
+
+	// Snapshots are created as snapshot requests, which give the URI to
+	// download the archive from. Earlier requests are ignored when looking for
+	// ours.
+	before, err := a.listSnapshotRequests(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity snapshot requests",
+			fmt.Sprintf("Could not list snapshot requests: %s", err),
+		)
+		return
+	}
+	earlier := make(map[string]bool, len(before))
+	for _, request := range before {
+		earlier[request.ResourceURI] = true
+	}
+
+	request := true
+	snapshotRequest := &command.SnapshotRequest{
+		Request:                  &request,
+		IncludeDiagnosticMetrics: data.IncludeDiagnosticMetrics.ValueBoolPointer(),
+	}
+	if !data.LimitHours.IsNull() {
+		limit := int(data.LimitHours.ValueInt64())
+		snapshotRequest.Limit = &limit
+	}
+	if !data.EndLimitHours.IsNull() {
+		endLimit := int(data.EndLimitHours.ValueInt64())
+		snapshotRequest.EndLimit = &endLimit
+	}
+
+	tflog.Info(ctx, "Creating Infinity snapshot")
+	if _, err := a.InfinityClient.Command().CreateSnapshot(ctx, snapshotRequest); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Infinity snapshot",
+			fmt.Sprintf("Could not create snapshot: %s", err),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Generating snapshot, this can take a while"})
+
+	var snapshot status.SnapshotRequest
+	state := ""
+	started := time.Now()
+	err = pollUntil(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		requests, err := a.listSnapshotRequests(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, request := range requests {
+			if earlier[request.ResourceURI] {
+				continue
+			}
+			snapshot = request
+			if request.State != state {
+				state = request.State
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Snapshot is %s after %s", request.State, time.Since(started).Round(time.Second)),
+				})
+			}
+			if requestFailed(request.State) {
+				return false, fmt.Errorf("snapshot %s: %s", request.State, request.Message)
+			}
+			return request.DownloadURI != "", nil
+		}
+		return false, nil
+	})
+	if errors.Is(err, errPollTimeout) {
+		resp.Diagnostics.AddError(
+			"Infinity Snapshot Timed Out",
+			fmt.Sprintf("The Manager did not generate the snapshot within %s. It may still be generating it.", timeout),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Infinity snapshot",
+			fmt.Sprintf("Could not create snapshot: %s", err),
+		)
+		return
+	}
+
+	target := downloadTarget(data.Path.ValueString(), snapshot.DownloadURI)
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Downloading snapshot to %s", target)})
+
+	size, checksum, err := downloadFile(ctx, a.files, snapshot.DownloadURI, target)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Downloading Infinity snapshot",
+			fmt.Sprintf("Could not download snapshot to %s: %s", target, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Downloaded Infinity snapshot to %s", target))
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Downloaded snapshot to %s: %d bytes, SHA-256 %s", target, size, checksum),
+	})
+}
+
+func (a *InfinitySnapshotAction) listSnapshotRequests(ctx context.Context) ([]status.SnapshotRequest, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]status.SnapshotRequest, string, error) {
+		page, err := a.InfinityClient.Status().ListSnapshotRequests(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+}
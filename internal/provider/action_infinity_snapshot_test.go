@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+const testSnapshotDownloadURI = "/api/admin/configuration/v1/diagnostic_snapshot/diagnostic_snapshot_mgr01_25_06_01_08_30_00.tgz/"
+
+// snapshotManager stands in for the Manager's snapshot API. A snapshot request
+// is in progress for a number of polls, and then complete with a download URI.
+type snapshotManager struct {
+	t        *testing.T
+	archive  []byte
+	polls    int
+	failWith string
+
+	mu      sync.Mutex
+	request *command.SnapshotRequest
+}
+
+func (m *snapshotManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/admin/status/v1/snapshot_request/":
+		var list status.SnapshotRequestListResponse
+		if m.request != nil {
+			request := status.SnapshotRequest{ResourceURI: "/api/admin/status/v1/snapshot_request/1/", State: "IN_PROGRESS"}
+			m.polls--
+			switch {
+			case m.polls < 0 && m.failWith != "":
+				request.State = "FAILED"
+				request.Message = m.failWith
+			case m.polls < 0:
+				request.State = "COMPLETE"
+				request.DownloadURI = testSnapshotDownloadURI
+			}
+			list.Objects = append(list.Objects, request)
+		}
+		require.NoError(m.t, json.NewEncoder(w).Encode(list))
+	case r.Method == http.MethodPost && r.URL.Path == "/api/admin/command/v1/snapshot/":
+		m.request = &command.SnapshotRequest{}
+		require.NoError(m.t, json.NewDecoder(r.Body).Decode(m.request))
+		require.NoError(m.t, json.NewEncoder(w).Encode(command.CommandResponse{Status: "success"}))
+	case r.Method == http.MethodGet && r.URL.Path == testSnapshotDownloadURI:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(m.archive)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestSnapshotAction(t *testing.T, manager http.Handler) *InfinitySnapshotAction {
+	server := httptest.NewServer(manager)
+	t.Cleanup(server.Close)
+
+	client, err := infinity.New(infinity.WithBaseURL(server.URL), infinity.WithBasicAuth("admin", "admin"), infinity.WithNoRetries())
+	require.NoError(t, err)
+	files, err := newFileClient(server.URL, server.Client(), nil, "")
+	require.NoError(t, err)
+	return &InfinitySnapshotAction{InfinityClient: client, files: files, pollInterval: time.Millisecond}
+}
+
+func TestInfinitySnapshotAction(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	client.On("GetJSON", mock.Anything, "status/v1/snapshot_request/", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	testInfinitySnapshotAction(t, client)
+}
+
+func testInfinitySnapshotAction(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				// The test provider has no client for file transfers
+				Config:      test.LoadTestFolder(t, "action_infinity_snapshot_basic"),
+				ExpectError: regexp.MustCompile("File Transfers Not Configured"),
+			},
+		},
+	})
+}
+
+func TestInfinitySnapshotAction_Invoke(t *testing.T) {
+	archive := []byte("snapshot archive")
+	sum := sha256.Sum256(archive)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("time window", func(t *testing.T) {
+		manager := &snapshotManager{t: t, archive: archive, polls: 2}
+		a := newTestSnapshotAction(t, manager)
+		dir := t.TempDir()
+		target := filepath.Join(dir, "diagnostic_snapshot_mgr01_25_06_01_08_30_00.tgz")
+
+		diags, progress := invokeTestAction(t, a, map[string]tftypes.Value{
+			"path":                       tftypes.NewValue(tftypes.String, dir),
+			"limit_hours":                tftypes.NewValue(tftypes.Number, 4),
+			"end_limit_hours":            tftypes.NewValue(tftypes.Number, 2),
+			"include_diagnostic_metrics": tftypes.NewValue(tftypes.Bool, true),
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+
+		request, limit, endLimit, metrics := true, 4, 2, true
+		require.Equal(t, &command.SnapshotRequest{Request: &request, Limit: &limit, EndLimit: &endLimit, IncludeDiagnosticMetrics: &metrics}, manager.request)
+
+		written, err := os.ReadFile(target)
+		require.NoError(t, err)
+		require.Equal(t, archive, written)
+		require.Len(t, progress, 5)
+		require.Equal(t, "Generating snapshot, this can take a while", progress[0])
+		require.Regexp(t, `^Snapshot is IN_PROGRESS after \d+s$`, progress[1])
+		require.Regexp(t, `^Snapshot is COMPLETE after \d+s$`, progress[2])
+		require.Equal(t, "Downloading snapshot to "+target, progress[3])
+		require.Equal(t, fmt.Sprintf("Downloaded snapshot to %s: %d bytes, SHA-256 %s", target, len(archive), checksum), progress[4])
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		manager := &snapshotManager{t: t, archive: archive}
+		a := newTestSnapshotAction(t, manager)
+
+		diags, _ := invokeTestAction(t, a, map[string]tftypes.Value{
+			"path": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "snapshot.tgz")),
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+
+		request := true
+		require.Equal(t, &command.SnapshotRequest{Request: &request}, manager.request)
+	})
+
+	t.Run("snapshot failed", func(t *testing.T) {
+		a := newTestSnapshotAction(t, &snapshotManager{t: t, archive: archive, failWith: "Not enough disk space"})
+
+		diags, _ := invokeTestAction(t, a, map[string]tftypes.Value{
+			"path": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "snapshot.tgz")),
+		})
+		require.True(t, diags.HasError())
+		require.Equal(t, "Could not create snapshot: snapshot FAILED: Not enough disk space", diags.Errors()[0].Detail())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		a := newTestSnapshotAction(t, &snapshotManager{t: t, archive: archive, polls: 1000})
+
+		diags, _ := invokeTestAction(t, a, map[string]tftypes.Value{
+			"path":    tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "snapshot.tgz")),
+			"timeout": tftypes.NewValue(tftypes.String, "50ms"),
+		})
+		require.True(t, diags.HasError())
+		require.Equal(t, "Infinity Snapshot Timed Out", diags.Errors()[0].Summary())
+	})
+}
@@ -0,0 +1,110 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/history"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/pexip/go-infinity-sdk/v38/types"
+)
+
+var errReadOnly = errors.New("the provider is configured with read_only = true, so no changes are sent to the Infinity Manager")
+
+// readOnlyClient wraps an InfinityClient and rejects every request that could
+// change the Manager's configuration. GET requests are passed through.
+type readOnlyClient struct {
+	InfinityClient
+
+	config  *config.Service
+	status  *status.Service
+	history *history.Service
+	command *command.Service
+}
+
+func newReadOnlyClient(client InfinityClient) *readOnlyClient {
+	c := &readOnlyClient{InfinityClient: client}
+	c.config = config.New(c)
+	c.status = status.New(c)
+	c.history = history.New(c)
+	c.command = command.New(c)
+	return c
+}
+
+// ReadOnly reports that the client does not send any changes.
+func (c *readOnlyClient) ReadOnly() bool {
+	return true
+}
+
+// checkReadOnly adds an error diagnostic and returns true if client is read
+// only. Resources call it before a create, update or delete, and actions before
+// they are invoked, so that nothing is sent to the Manager.
+func checkReadOnly(client InfinityClient, operation string, diags *diag.Diagnostics) bool {
+	if c, ok := client.(interface{ ReadOnly() bool }); !ok || !c.ReadOnly() {
+		return false
+	}
+	diags.AddError(
+		"Provider Is Read Only",
+		fmt.Sprintf("Cannot %s: %s. Unset read_only or PEXIP_READ_ONLY to apply changes.", operation, errReadOnly),
+	)
+	return true
+}
+
+func (c *readOnlyClient) Config() *config.Service {
+	return c.config
+}
+
+func (c *readOnlyClient) Status() *status.Service {
+	return c.status
+}
+
+func (c *readOnlyClient) History() *history.Service {
+	return c.history
+}
+
+func (c *readOnlyClient) Command() *command.Service {
+	return c.command
+}
+
+func (c *readOnlyClient) PostJSON(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	return errReadOnly
+}
+
+func (c *readOnlyClient) PutJSON(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	return errReadOnly
+}
+
+func (c *readOnlyClient) PatchJSON(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	return errReadOnly
+}
+
+func (c *readOnlyClient) DeleteJSON(ctx context.Context, endpoint string, result interface{}) error {
+	return errReadOnly
+}
+
+func (c *readOnlyClient) PostMultipartFormWithFieldsAndResponse(ctx context.Context, endpoint string, fields map[string]string, fileFieldName, filename string, fileContent io.Reader, result interface{}) (*types.PostResponse, error) {
+	return nil, errReadOnly
+}
+
+func (c *readOnlyClient) PostMultipartFormWithFieldsAndResponseUUID(ctx context.Context, endpoint string, fields map[string]string, fileFieldName, filename string, fileContent io.Reader, result interface{}) (*types.PostResponseWithUUID, error) {
+	return nil, errReadOnly
+}
+
+func (c *readOnlyClient) PatchMultipartFormWithFieldsAndResponse(ctx context.Context, endpoint string, fields map[string]string, fileFieldName, filename string, fileContent io.Reader, result interface{}) (*types.PostResponse, error) {
+	return nil, errReadOnly
+}
+
+func (c *readOnlyClient) PostWithResponse(ctx context.Context, endpoint string, body interface{}, result interface{}) (*types.PostResponse, error) {
+	return nil, errReadOnly
+}
@@ -0,0 +1,65 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyClient(t *testing.T) {
+	client := infinity.NewClientMock()
+	client.On("GetJSON", mock.Anything, "configuration/v1/dns_server/1/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		dns := args.Get(3).(*config.DNSServer)
+		dns.Address = "192.0.2.53"
+	}).Once()
+
+	readOnly := newReadOnlyClient(client)
+
+	dns, err := readOnly.Config().GetDNSServer(t.Context(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "192.0.2.53", dns.Address)
+
+	_, err = readOnly.Config().CreateDNSServer(t.Context(), &config.DNSServerCreateRequest{Address: "192.0.2.54"})
+	require.ErrorIs(t, err, errReadOnly)
+	_, err = readOnly.Config().UpdateDNSServer(t.Context(), 1, &config.DNSServerUpdateRequest{Address: "192.0.2.54"})
+	require.ErrorIs(t, err, errReadOnly)
+	require.ErrorIs(t, readOnly.Config().DeleteDNSServer(t.Context(), 1), errReadOnly)
+
+	client.AssertExpectations(t)
+}
+
+func TestCheckReadOnly(t *testing.T) {
+	var diags diag.Diagnostics
+	require.False(t, checkReadOnly(infinity.NewClientMock(), "create Infinity DNS server", &diags))
+	require.False(t, diags.HasError())
+
+	require.True(t, checkReadOnly(newReadOnlyClient(infinity.NewClientMock()), "create Infinity DNS server", &diags))
+	require.Len(t, diags, 1)
+	require.Equal(t, "Provider Is Read Only", diags[0].Summary())
+	require.Contains(t, diags[0].Detail(), "Cannot create Infinity DNS server")
+}
+
+func TestReadOnlyClient_ResourceCreate(t *testing.T) {
+	client := infinity.NewClientMock()
+	r := &InfinityDnsServerResource{InfinityClient: newReadOnlyClient(client)}
+
+	resp := &resource.CreateResponse{}
+	r.Create(t.Context(), resource.CreateRequest{}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, "Provider Is Read Only", resp.Diagnostics[0].Summary())
+
+	// Nothing is sent to the Manager
+	client.AssertNotCalled(t, "PostWithResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	client.AssertNotCalled(t, "PostJSON", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
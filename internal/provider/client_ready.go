@@ -0,0 +1,190 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/history"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/pexip/go-infinity-sdk/v38/types"
+)
+
+const (
+	defaultWaitForReadyTimeout  = 10 * time.Minute
+	defaultWaitForReadyInterval = 10 * time.Second
+)
+
+// readyClient wraps an InfinityClient so that the first request waits until the
+// Manager is ready. This lets the provider configure a Management node that is
+// built earlier in the same apply, where the API is not up yet when the first
+// resources are created.
+type readyClient struct {
+	client   InfinityClient
+	timeout  time.Duration
+	interval time.Duration
+
+	mu   sync.Mutex
+	done bool
+
+	config  *config.Service
+	status  *status.Service
+	history *history.Service
+	command *command.Service
+}
+
+func newReadyClient(client InfinityClient, timeout time.Duration) *readyClient {
+	c := &readyClient{
+		client:   client,
+		timeout:  timeout,
+		interval: defaultWaitForReadyInterval,
+	}
+	c.config = config.New(c)
+	c.status = status.New(c)
+	c.history = history.New(c)
+	c.command = command.New(c)
+	return c
+}
+
+// waitForReady polls the Manager until it answers an authenticated request
+// and the primary Management node reports its version, which it does once the
+// initial configuration has finished. Only the first caller waits; if the
+// Manager does not become ready within the timeout, that caller gets the error
+// and later requests are sent without waiting again.
+func (c *readyClient) waitForReady(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done {
+		return nil
+	}
+	c.done = true
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting up to %s for the Infinity Manager to become ready", c.timeout))
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var lastErr error
+	for {
+		if lastErr = c.checkReady(ctx); lastErr == nil {
+			tflog.Info(ctx, "Infinity Manager is ready")
+			return nil
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Infinity Manager is not ready yet: %s", lastErr))
+
+		timer := time.NewTimer(c.interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("the Infinity Manager was not ready after %s: %w", c.timeout, lastErr)
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *readyClient) checkReady(ctx context.Context) error {
+	vms, err := c.client.Status().ListManagementVMs(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, vm := range vms.Objects {
+		if vm.Primary && vm.Version != "" {
+			return nil
+		}
+	}
+	return errors.New("the Management node has not finished its initial configuration")
+}
+
+func (c *readyClient) Config() *config.Service {
+	return c.config
+}
+
+func (c *readyClient) Status() *status.Service {
+	return c.status
+}
+
+func (c *readyClient) History() *history.Service {
+	return c.history
+}
+
+func (c *readyClient) Command() *command.Service {
+	return c.command
+}
+
+func (c *readyClient) GetJSON(ctx context.Context, endpoint string, queryParams *url.Values, result interface{}) error {
+	if err := c.waitForReady(ctx); err != nil {
+		return err
+	}
+	return c.client.GetJSON(ctx, endpoint, queryParams, result)
+}
+
+func (c *readyClient) PostJSON(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	if err := c.waitForReady(ctx); err != nil {
+		return err
+	}
+	return c.client.PostJSON(ctx, endpoint, body, result)
+}
+
+func (c *readyClient) PutJSON(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	if err := c.waitForReady(ctx); err != nil {
+		return err
+	}
+	return c.client.PutJSON(ctx, endpoint, body, result)
+}
+
+func (c *readyClient) PatchJSON(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	if err := c.waitForReady(ctx); err != nil {
+		return err
+	}
+	return c.client.PatchJSON(ctx, endpoint, body, result)
+}
+
+func (c *readyClient) DeleteJSON(ctx context.Context, endpoint string, result interface{}) error {
+	if err := c.waitForReady(ctx); err != nil {
+		return err
+	}
+	return c.client.DeleteJSON(ctx, endpoint, result)
+}
+
+func (c *readyClient) PostMultipartFormWithFieldsAndResponse(ctx context.Context, endpoint string, fields map[string]string, fileFieldName, filename string, fileContent io.Reader, result interface{}) (*types.PostResponse, error) {
+	if err := c.waitForReady(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.PostMultipartFormWithFieldsAndResponse(ctx, endpoint, fields, fileFieldName, filename, fileContent, result)
+}
+
+func (c *readyClient) PostMultipartFormWithFieldsAndResponseUUID(ctx context.Context, endpoint string, fields map[string]string, fileFieldName, filename string, fileContent io.Reader, result interface{}) (*types.PostResponseWithUUID, error) {
+	if err := c.waitForReady(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.PostMultipartFormWithFieldsAndResponseUUID(ctx, endpoint, fields, fileFieldName, filename, fileContent, result)
+}
+
+func (c *readyClient) PatchMultipartFormWithFieldsAndResponse(ctx context.Context, endpoint string, fields map[string]string, fileFieldName, filename string, fileContent io.Reader, result interface{}) (*types.PostResponse, error) {
+	if err := c.waitForReady(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.PatchMultipartFormWithFieldsAndResponse(ctx, endpoint, fields, fileFieldName, filename, fileContent, result)
+}
+
+func (c *readyClient) PostWithResponse(ctx context.Context, endpoint string, body interface{}, result interface{}) (*types.PostResponse, error) {
+	if err := c.waitForReady(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.PostWithResponse(ctx, endpoint, body, result)
+}
@@ -0,0 +1,69 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadyClient_WaitsForManager(t *testing.T) {
+	client := infinity.NewClientMock()
+
+	// The API is not up yet, then the initial configuration is still running,
+	// then the Manager is ready
+	client.On("GetJSON", mock.Anything, "status/v1/management_vm/", mock.Anything, mock.Anything).
+		Return(&url.Error{Op: "Get", URL: "https://manager.example.com", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host"}}}).Once()
+	client.On("GetJSON", mock.Anything, "status/v1/management_vm/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		vms := args.Get(3).(*status.ManagementVMListResponse)
+		vms.Objects = []status.ManagementVM{{Name: "mgr", Primary: true}}
+	}).Once()
+	client.On("GetJSON", mock.Anything, "status/v1/management_vm/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		vms := args.Get(3).(*status.ManagementVMListResponse)
+		vms.Objects = []status.ManagementVM{{Name: "mgr", Primary: true, Version: "38.0"}}
+	}).Once()
+	client.On("GetJSON", mock.Anything, "configuration/v1/dns_server/1/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		dns := args.Get(3).(*config.DNSServer)
+		dns.Address = "192.0.2.53"
+	}).Twice()
+
+	ready := newReadyClient(client, time.Second)
+	ready.interval = time.Millisecond
+
+	for i := 0; i < 2; i++ {
+		dns, err := ready.Config().GetDNSServer(t.Context(), 1)
+		require.NoError(t, err)
+		require.Equal(t, "192.0.2.53", dns.Address)
+	}
+	client.AssertExpectations(t)
+}
+
+func TestReadyClient_Timeout(t *testing.T) {
+	client := infinity.NewClientMock()
+	client.On("GetJSON", mock.Anything, "status/v1/management_vm/", mock.Anything, mock.Anything).
+		Return(&infinity.APIError{StatusCode: 401, Message: "Unauthorized"})
+	client.On("DeleteJSON", mock.Anything, "configuration/v1/dns_server/1/", mock.Anything).Return(nil).Once()
+
+	ready := newReadyClient(client, 20*time.Millisecond)
+	ready.interval = time.Millisecond
+
+	err := ready.Config().DeleteDNSServer(t.Context(), 1)
+	require.ErrorContains(t, err, "the Infinity Manager was not ready after 20ms")
+	require.Equal(t, ErrorClassAuth, classifyError(err))
+
+	// Later requests do not wait again
+	require.NoError(t, ready.Config().DeleteDNSServer(t.Context(), 1))
+	client.AssertExpectations(t)
+}
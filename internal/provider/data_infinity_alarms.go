@@ -0,0 +1,185 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pexip/go-infinity-sdk/v38/status"
+)
+
+type InfinityAlarmsDataSource struct {
+	InfinityClient InfinityClient
+}
+
+type InfinityAlarmsModel struct {
+	ID     types.String         `tfsdk:"id"`
+	Level  types.String         `tfsdk:"level"`
+	Node   types.String         `tfsdk:"node"`
+	Name   types.String         `tfsdk:"name"`
+	Alarms []InfinityAlarmModel `tfsdk:"alarms"`
+}
+
+type InfinityAlarmModel struct {
+	ID           types.String `tfsdk:"id"`
+	ResourceID   types.Int32  `tfsdk:"resource_id"`
+	Name         types.String `tfsdk:"name"`
+	Level        types.String `tfsdk:"level"`
+	Node         types.String `tfsdk:"node"`
+	Instance     types.String `tfsdk:"instance"`
+	Details      types.String `tfsdk:"details"`
+	Acknowledged types.Bool   `tfsdk:"acknowledged"`
+	TimeRaised   types.String `tfsdk:"time_raised"`
+}
+
+func (d *InfinityAlarmsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_alarms"
+}
+
+func (d *InfinityAlarmsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+	d.InfinityClient = p.client
+}
+
+func (d *InfinityAlarmsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the data source.",
+			},
+			"level": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return alarms with this severity, e.g. `critical`, `error` or `warning`. Case insensitive.",
+			},
+			"node": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return alarms raised by the node with this name or address.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return alarms with this name, e.g. `Certificate expiring`.",
+			},
+			"alarms": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Resource URI of the alarm in Infinity.",
+						},
+						"resource_id": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The resource integer identifier of the alarm in Infinity.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the alarm.",
+						},
+						"level": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The severity of the alarm.",
+						},
+						"node": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The node that raised the alarm.",
+						},
+						"instance": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The object the alarm is about, e.g. a certificate or a gateway.",
+						},
+						"details": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A description of the problem.",
+						},
+						"acknowledged": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether an administrator has acknowledged the alarm.",
+						},
+						"time_raised": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the alarm was raised, in RFC 3339 format.",
+						},
+					},
+				},
+				MarkdownDescription: "The active alarms that match the filters.",
+			},
+		},
+		MarkdownDescription: "Reads the active alarms from the Infinity status API.",
+	}
+}
+
+func (d *InfinityAlarmsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InfinityAlarmsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarms, err := listAlarms(ctx, d.InfinityClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity alarms",
+			fmt.Sprintf("Could not list alarms: %s", err),
+		)
+		return
+	}
+
+	state.Alarms = []InfinityAlarmModel{}
+	for _, alarm := range alarms {
+		if !state.Level.IsNull() && !strings.EqualFold(alarm.Level, state.Level.ValueString()) {
+			continue
+		}
+		if !state.Node.IsNull() && alarm.Node != state.Node.ValueString() {
+			continue
+		}
+		if !state.Name.IsNull() && alarm.Name != state.Name.ValueString() {
+			continue
+		}
+		state.Alarms = append(state.Alarms, InfinityAlarmModel{
+			ID:           types.StringValue(alarm.ResourceURI),
+			ResourceID:   types.Int32Value(int32(alarm.ID)), // #nosec G115 -- API values are expected to be within int32 range
+			Name:         types.StringValue(alarm.Name),
+			Level:        types.StringValue(alarm.Level),
+			Node:         types.StringValue(alarm.Node),
+			Instance:     types.StringValue(alarm.Instance),
+			Details:      types.StringValue(alarm.Details),
+			Acknowledged: types.BoolValue(alarm.Acknowledged),
+			TimeRaised:   timeValue(alarm.TimeRaised),
+		})
+	}
+	state.ID = types.StringValue("/api/admin/status/v1/alarm/")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// listAlarms returns all active alarms, reading all pages of the status API.
+func listAlarms(ctx context.Context, client InfinityClient) ([]status.Alarm, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]status.Alarm, string, error) {
+		page, err := client.Status().ListAlarms(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+}
@@ -0,0 +1,141 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/pexip/go-infinity-sdk/v38/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+func mockAlarms(client *infinity.ClientMock, alarms ...status.Alarm) {
+	client.On("GetJSON", mock.Anything, "status/v1/alarm/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		list := args.Get(3).(*status.AlarmListResponse)
+		list.Objects = alarms
+	}).Maybe()
+}
+
+var testAlarms = []status.Alarm{
+	{
+		ID:          1,
+		Name:        "Certificate expired",
+		Level:       "critical",
+		Node:        "10.0.0.10",
+		Instance:    "manager.example.com",
+		Details:     "The TLS certificate expired on 2025-05-01",
+		TimeRaised:  &util.InfinityTime{Time: time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC)},
+		ResourceURI: "/api/admin/status/v1/alarm/1/",
+	},
+	{
+		ID:           2,
+		Name:         "Licence expiring",
+		Level:        "warning",
+		Node:         "10.0.0.10",
+		Acknowledged: true,
+		ResourceURI:  "/api/admin/status/v1/alarm/2/",
+	},
+	{
+		ID:          3,
+		Name:        "Failed to register",
+		Level:       "error",
+		Node:        "10.0.0.11",
+		ResourceURI: "/api/admin/status/v1/alarm/3/",
+	},
+}
+
+func TestInfinityAlarms(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockAlarms(client, testAlarms...)
+
+	testInfinityAlarms(t, client)
+}
+
+func testInfinityAlarms(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "data_infinity_alarms_basic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pexip_infinity_alarms.all", "alarms.#", "3"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_alarms.critical", "alarms.#", "1"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_alarms.critical", "alarms.0.name", "Certificate expired"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_alarms.critical", "alarms.0.time_raised", "2025-06-01T08:30:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestInfinityAlarmsDataSource_Read(t *testing.T) {
+	client := infinity.NewClientMock()
+	mockAlarms(client, testAlarms...)
+	d := &InfinityAlarmsDataSource{InfinityClient: client}
+
+	tests := []struct {
+		name   string
+		attrs  map[string]tftypes.Value
+		alarms []string
+	}{
+		{
+			name:   "all",
+			alarms: []string{"Certificate expired", "Licence expiring", "Failed to register"},
+		},
+		{
+			name:   "level is case insensitive",
+			attrs:  map[string]tftypes.Value{"level": tftypes.NewValue(tftypes.String, "ERROR")},
+			alarms: []string{"Failed to register"},
+		},
+		{
+			name:   "node",
+			attrs:  map[string]tftypes.Value{"node": tftypes.NewValue(tftypes.String, "10.0.0.10")},
+			alarms: []string{"Certificate expired", "Licence expiring"},
+		},
+		{
+			name: "node and name",
+			attrs: map[string]tftypes.Value{
+				"node": tftypes.NewValue(tftypes.String, "10.0.0.10"),
+				"name": tftypes.NewValue(tftypes.String, "Licence expiring"),
+			},
+			alarms: []string{"Licence expiring"},
+		},
+		{
+			name:   "no match",
+			attrs:  map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "Disk full")},
+			alarms: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := readTestDataSource(t, d, tt.attrs)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			var model InfinityAlarmsModel
+			require.False(t, state.Get(t.Context(), &model).HasError())
+			names := []string{}
+			for _, alarm := range model.Alarms {
+				names = append(names, alarm.Name.ValueString())
+			}
+			require.Equal(t, tt.alarms, names)
+		})
+	}
+}
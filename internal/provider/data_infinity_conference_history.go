@@ -0,0 +1,275 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pexip/go-infinity-sdk/v38/history"
+	"github.com/pexip/go-infinity-sdk/v38/options"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+)
+
+type InfinityConferenceHistoryDataSource struct {
+	InfinityClient InfinityClient
+}
+
+type InfinityConferenceHistoryModel struct {
+	ID          types.String                           `tfsdk:"id"`
+	StartTime   types.String                           `tfsdk:"start_time"`
+	EndTime     types.String                           `tfsdk:"end_time"`
+	Name        types.String                           `tfsdk:"name"`
+	Tag         types.String                           `tfsdk:"tag"`
+	Conferences []InfinityConferenceHistoryRecordModel `tfsdk:"conferences"`
+}
+
+type InfinityConferenceHistoryRecordModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ResourceID          types.Int32  `tfsdk:"resource_id"`
+	Name                types.String `tfsdk:"name"`
+	ServiceType         types.String `tfsdk:"service_type"`
+	Tag                 types.String `tfsdk:"tag"`
+	StartTime           types.String `tfsdk:"start_time"`
+	EndTime             types.String `tfsdk:"end_time"`
+	DurationSeconds     types.Int64  `tfsdk:"duration_seconds"`
+	ParticipantCount    types.Int32  `tfsdk:"participant_count"`
+	MaxConcurrentGuests types.Int32  `tfsdk:"max_concurrent_guests"`
+	MaxConcurrentHosts  types.Int32  `tfsdk:"max_concurrent_hosts"`
+	InstanceType        types.String `tfsdk:"instance_type"`
+}
+
+func (d *InfinityConferenceHistoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_conference_history"
+}
+
+func (d *InfinityConferenceHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+	d.InfinityClient = p.client
+}
+
+func (d *InfinityConferenceHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the data source.",
+			},
+			"start_time": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return conferences that started at or after this time, in RFC 3339 format, e.g. `2025-06-01T00:00:00Z`.",
+			},
+			"end_time": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return conferences that ended before this time, in RFC 3339 format.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return conferences with this name, e.g. the name of a `pexip_infinity_conference`.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return conferences with this tag.",
+			},
+			"conferences": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Resource URI of the conference history record in Infinity.",
+						},
+						"resource_id": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The resource integer identifier of the conference history record in Infinity.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the conference.",
+						},
+						"service_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of service, e.g. `conference` or `gateway`.",
+						},
+						"tag": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The tag of the conference.",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the conference started, in RFC 3339 format.",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the conference ended, in RFC 3339 format.",
+						},
+						"duration_seconds": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "How long the conference lasted, in seconds.",
+						},
+						"participant_count": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The total number of participants that joined the conference.",
+						},
+						"max_concurrent_guests": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The largest number of Guests in the conference at the same time.",
+						},
+						"max_concurrent_hosts": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The largest number of Hosts in the conference at the same time.",
+						},
+						"instance_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of conference instance.",
+						},
+					},
+				},
+				MarkdownDescription: "The conferences that match the filters.",
+			},
+		},
+		MarkdownDescription: "Reads past conferences from the Infinity history API, e.g. to check that a Virtual Meeting Room has been used before it is removed.",
+	}
+}
+
+func (d *InfinityConferenceHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InfinityConferenceHistoryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := parseHistoryWindow(state.StartTime, state.EndTime, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]history.ConferenceRecord, string, error) {
+		params := window.listOptions(opts, state.Name.ValueString()).ToURLValues()
+		if !state.Tag.IsNull() {
+			params.Set("tag", state.Tag.ValueString())
+		}
+		var page history.ConferenceRecordListResponse
+		if err := d.InfinityClient.GetJSON(ctx, "history/v1/conference/", &params, &page); err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity conference history",
+			fmt.Sprintf("Could not list conference history: %s", err),
+		)
+		return
+	}
+
+	state.Conferences = []InfinityConferenceHistoryRecordModel{}
+	for _, record := range records {
+		if !window.contains(record.StartTime.Time, record.EndTime.Time) {
+			continue
+		}
+		if !state.Name.IsNull() && record.Name != state.Name.ValueString() {
+			continue
+		}
+		if !state.Tag.IsNull() && record.Tag != state.Tag.ValueString() {
+			continue
+		}
+		state.Conferences = append(state.Conferences, InfinityConferenceHistoryRecordModel{
+			ID:                  types.StringValue(record.ResourceURI),
+			ResourceID:          types.Int32Value(int32(record.ID)), // #nosec G115 -- API values are expected to be within int32 range
+			Name:                types.StringValue(record.Name),
+			ServiceType:         types.StringValue(record.ServiceType),
+			Tag:                 types.StringValue(record.Tag),
+			StartTime:           timeValue(&record.StartTime),
+			EndTime:             timeValue(&record.EndTime),
+			DurationSeconds:     types.Int64Value(int64(record.DurationSeconds)),
+			ParticipantCount:    types.Int32Value(int32(record.TotalParticipants)),   // #nosec G115 -- API values are expected to be within int32 range
+			MaxConcurrentGuests: types.Int32Value(int32(record.MaxConcurrentGuests)), // #nosec G115 -- API values are expected to be within int32 range
+			MaxConcurrentHosts:  types.Int32Value(int32(record.MaxConcurrentHosts)),  // #nosec G115 -- API values are expected to be within int32 range
+			InstanceType:        types.StringValue(record.InstanceType),
+		})
+	}
+	state.ID = types.StringValue("/api/admin/history/v1/conference/")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// historyWindow is the time window of a history query. A nil bound is open.
+type historyWindow struct {
+	start *time.Time
+	end   *time.Time
+}
+
+// parseHistoryWindow parses the start_time and end_time attributes of a
+// history data source, adding an attribute error for values that are not in
+// RFC 3339 format.
+func parseHistoryWindow(start, end types.String, diags *diag.Diagnostics) historyWindow {
+	var window historyWindow
+	for _, bound := range []struct {
+		name  string
+		value types.String
+		time  **time.Time
+	}{
+		{"start_time", start, &window.start},
+		{"end_time", end, &window.end},
+	} {
+		if bound.value.IsNull() || bound.value.IsUnknown() {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, bound.value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(bound.name),
+				"Invalid Time",
+				fmt.Sprintf("%s must be in RFC 3339 format, e.g. 2025-06-01T00:00:00Z: %s", bound.name, err),
+			)
+			continue
+		}
+		*bound.time = &t
+	}
+	return window
+}
+
+// listOptions returns the options for one page of a history list request
+// within the window, with an optional name search.
+func (w historyWindow) listOptions(opts *status.ListOptions, search string) *history.ListOptions {
+	return &history.ListOptions{
+		SearchableListOptions: options.SearchableListOptions{BaseListOptions: *opts, Search: search},
+		StartTime:             w.start,
+		EndTime:               w.end,
+	}
+}
+
+// contains reports whether a record that started and ended at the given times
+// is within the window. The API filters on the window as well, this guards
+// against endpoints that ignore the time filter.
+func (w historyWindow) contains(start, end time.Time) bool {
+	if w.start != nil && start.Before(*w.start) {
+		return false
+	}
+	if w.end != nil && !end.IsZero() && !end.Before(*w.end) {
+		return false
+	}
+	return true
+}
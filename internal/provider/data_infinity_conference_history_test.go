@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/history"
+	"github.com/pexip/go-infinity-sdk/v38/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+func historyTime(day, hour int) util.InfinityTime {
+	return util.InfinityTime{Time: time.Date(2025, 6, day, hour, 0, 0, 0, time.UTC)}
+}
+
+func mockConferenceHistory(client *infinity.ClientMock) {
+	client.On("GetJSON", mock.Anything, "history/v1/conference/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		list := args.Get(3).(*history.ConferenceRecordListResponse)
+		list.Objects = []history.ConferenceRecord{
+			{
+				ID:                  1,
+				Name:                "weekly-standup",
+				ServiceType:         "conference",
+				Tag:                 "team-a",
+				StartTime:           historyTime(2, 9),
+				EndTime:             historyTime(2, 10),
+				DurationSeconds:     3600,
+				TotalParticipants:   7,
+				MaxConcurrentGuests: 4,
+				MaxConcurrentHosts:  2,
+				ResourceURI:         "/api/admin/history/v1/conference/1/",
+			},
+			{
+				ID:                2,
+				Name:              "weekly-standup",
+				ServiceType:       "conference",
+				Tag:               "team-a",
+				StartTime:         historyTime(9, 9),
+				EndTime:           historyTime(9, 10),
+				DurationSeconds:   3500,
+				TotalParticipants: 5,
+				ResourceURI:       "/api/admin/history/v1/conference/2/",
+			},
+			{
+				ID:                3,
+				Name:              "all-hands",
+				ServiceType:       "conference",
+				Tag:               "company",
+				StartTime:         historyTime(3, 15),
+				EndTime:           historyTime(3, 16),
+				DurationSeconds:   3600,
+				TotalParticipants: 120,
+				ResourceURI:       "/api/admin/history/v1/conference/3/",
+			},
+		}
+	}).Maybe()
+}
+
+func TestInfinityConferenceHistory(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockConferenceHistory(client)
+
+	testInfinityConferenceHistory(t, client)
+}
+
+func testInfinityConferenceHistory(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "data_infinity_conference_history_basic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_history.all", "conferences.#", "3"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_history.weekly", "conferences.#", "1"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_history.weekly", "conferences.0.participant_count", "7"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_history.weekly", "conferences.0.duration_seconds", "3600"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_history.weekly", "conferences.0.start_time", "2025-06-02T09:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestInfinityConferenceHistoryDataSource_Read(t *testing.T) {
+	client := infinity.NewClientMock()
+	mockConferenceHistory(client)
+	d := &InfinityConferenceHistoryDataSource{InfinityClient: client}
+
+	tests := []struct {
+		name        string
+		attrs       map[string]tftypes.Value
+		conferences []int32
+		wantErr     string
+	}{
+		{
+			name:        "all",
+			conferences: []int32{1, 2, 3},
+		},
+		{
+			name:        "name",
+			attrs:       map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "weekly-standup")},
+			conferences: []int32{1, 2},
+		},
+		{
+			name:        "tag",
+			attrs:       map[string]tftypes.Value{"tag": tftypes.NewValue(tftypes.String, "company")},
+			conferences: []int32{3},
+		},
+		{
+			name: "time window",
+			attrs: map[string]tftypes.Value{
+				"start_time": tftypes.NewValue(tftypes.String, "2025-06-02T09:00:00Z"),
+				"end_time":   tftypes.NewValue(tftypes.String, "2025-06-03T16:00:00Z"),
+			},
+			conferences: []int32{1},
+		},
+		{
+			name:    "invalid time",
+			attrs:   map[string]tftypes.Value{"start_time": tftypes.NewValue(tftypes.String, "2025-06-02")},
+			wantErr: "Invalid Time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := readTestDataSource(t, d, tt.attrs)
+			if tt.wantErr != "" {
+				require.True(t, diags.HasError())
+				require.Equal(t, tt.wantErr, diags.Errors()[0].Summary())
+				return
+			}
+			require.False(t, diags.HasError(), "%v", diags)
+
+			var model InfinityConferenceHistoryModel
+			require.False(t, state.Get(t.Context(), &model).HasError())
+			ids := []int32{}
+			for _, conference := range model.Conferences {
+				ids = append(ids, conference.ResourceID.ValueInt32())
+			}
+			require.Equal(t, tt.conferences, ids)
+		})
+	}
+}
+
+func TestInfinityConferenceHistoryDataSource_ReadQuery(t *testing.T) {
+	client := infinity.NewClientMock()
+	var query url.Values
+	client.On("GetJSON", mock.Anything, "history/v1/conference/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		query = *args.Get(2).(*url.Values)
+	})
+	d := &InfinityConferenceHistoryDataSource{InfinityClient: client}
+
+	_, diags := readTestDataSource(t, d, map[string]tftypes.Value{
+		"start_time": tftypes.NewValue(tftypes.String, "2025-06-01T00:00:00Z"),
+		"end_time":   tftypes.NewValue(tftypes.String, "2025-06-08T00:00:00Z"),
+		"name":       tftypes.NewValue(tftypes.String, "weekly-standup"),
+		"tag":        tftypes.NewValue(tftypes.String, "weekly"),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, "2025-06-01T00:00:00Z", query.Get("start_time__gte"))
+	require.Equal(t, "2025-06-08T00:00:00Z", query.Get("end_time__lt"))
+	require.Equal(t, "weekly-standup", query.Get("name__icontains"))
+	require.Equal(t, "weekly", query.Get("tag"))
+}
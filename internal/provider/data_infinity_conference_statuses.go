@@ -0,0 +1,275 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+)
+
+type InfinityConferenceStatusesDataSource struct {
+	InfinityClient InfinityClient
+}
+
+type InfinityConferenceStatusesModel struct {
+	ID          types.String                    `tfsdk:"id"`
+	Name        types.String                    `tfsdk:"name"`
+	Alias       types.String                    `tfsdk:"alias"`
+	Conferences []InfinityConferenceStatusModel `tfsdk:"conferences"`
+}
+
+type InfinityConferenceStatusModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ServiceType      types.String `tfsdk:"service_type"`
+	Tag              types.String `tfsdk:"tag"`
+	IsStarted        types.Bool   `tfsdk:"is_started"`
+	IsLocked         types.Bool   `tfsdk:"is_locked"`
+	GuestsMuted      types.Bool   `tfsdk:"guests_muted"`
+	StartTime        types.String `tfsdk:"start_time"`
+	ParticipantCount types.Int32  `tfsdk:"participant_count"`
+}
+
+func (d *InfinityConferenceStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_conference_statuses"
+}
+
+func (d *InfinityConferenceStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+	d.InfinityClient = p.client
+}
+
+func (d *InfinityConferenceStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the data source.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the conference with this name, e.g. the name of a `pexip_infinity_conference`.",
+			},
+			"alias": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the conference that this alias belongs to.",
+			},
+			"conferences": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Resource URI of the conference status in Infinity.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the conference.",
+						},
+						"service_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of service, e.g. `conference` or `gateway`.",
+						},
+						"tag": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The tag of the conference.",
+						},
+						"is_started": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether a Host has joined and started the conference.",
+						},
+						"is_locked": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the conference is locked.",
+						},
+						"guests_muted": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether all Guests are muted.",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the conference started, in RFC 3339 format.",
+						},
+						"participant_count": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of participants in the conference.",
+						},
+					},
+				},
+				MarkdownDescription: "The live conferences that match the filters.",
+			},
+		},
+		MarkdownDescription: "Reads the conferences that are in progress from the Infinity status API.",
+	}
+}
+
+func (d *InfinityConferenceStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InfinityConferenceStatusesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conferenceName := liveConferenceFilter(ctx, d.InfinityClient, state.Name, state.Alias, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conferences, err := listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]status.ConferenceStatus, string, error) {
+		params := opts.ToURLValues()
+		if conferenceName != nil {
+			params.Set("name", *conferenceName)
+		}
+		var page status.ConferenceListResponse
+		if err := d.InfinityClient.GetJSON(ctx, "status/v1/conference/", &params, &page); err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity conference status",
+			fmt.Sprintf("Could not list conference statuses: %s", err),
+		)
+		return
+	}
+
+	participants, err := listParticipantStatuses(ctx, d.InfinityClient, conferenceName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity participant status",
+			fmt.Sprintf("Could not list participant statuses: %s", err),
+		)
+		return
+	}
+	participantCounts := make(map[string]int32)
+	for _, participant := range participants {
+		participantCounts[participant.Conference]++
+	}
+
+	state.Conferences = []InfinityConferenceStatusModel{}
+	for _, conference := range conferences {
+		// The name is also checked here, in case the API ignored the filter
+		if conferenceName != nil && conference.Name != *conferenceName {
+			continue
+		}
+		state.Conferences = append(state.Conferences, InfinityConferenceStatusModel{
+			ID:               types.StringValue(conference.ResourceURI),
+			Name:             types.StringValue(conference.Name),
+			ServiceType:      types.StringValue(conference.ServiceType),
+			Tag:              types.StringValue(conference.Tag),
+			IsStarted:        types.BoolValue(conference.IsStarted),
+			IsLocked:         types.BoolValue(conference.IsLocked),
+			GuestsMuted:      types.BoolValue(conference.GuestsMuted),
+			StartTime:        timeValue(conference.StartTime),
+			ParticipantCount: types.Int32Value(participantCounts[conference.Name]),
+		})
+	}
+	state.ID = types.StringValue("/api/admin/status/v1/conference/")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// listParticipantStatuses returns every participant that is in a conference,
+// reading all pages of the status API. If conferenceName is not nil, the API is
+// asked for the participants of that conference only.
+func listParticipantStatuses(ctx context.Context, client InfinityClient, conferenceName *string) ([]status.Participant, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]status.Participant, string, error) {
+		params := opts.ToURLValues()
+		if conferenceName != nil {
+			params.Set("conference", *conferenceName)
+		}
+		var page status.ParticipantListResponse
+		if err := client.GetJSON(ctx, "status/v1/participant/", &params, &page); err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+}
+
+// liveConferenceFilter returns the name of the conference that the name and
+// alias attributes of a live status data source select, or nil if neither is
+// set. The status API does not know about aliases, so an alias is looked up in
+// the configuration API, which filters by alias. The callers then filter the
+// status API by conference name.
+func liveConferenceFilter(ctx context.Context, client InfinityClient, name, alias types.String, diags *diag.Diagnostics) *string {
+	if alias.IsNull() {
+		if name.IsNull() {
+			return nil
+		}
+		conferenceName := name.ValueString()
+		return &conferenceName
+	}
+
+	aliases, err := listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]config.ConferenceAlias, string, error) {
+		params := opts.ToURLValues()
+		params.Set("alias", alias.ValueString())
+		var page config.ConferenceAliasListResponse
+		if err := client.GetJSON(ctx, "configuration/v1/conference_alias/", &params, &page); err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Reading Infinity conference aliases",
+			fmt.Sprintf("Could not list conference aliases: %s", err),
+		)
+		return nil
+	}
+
+	// The alias is also compared here, in case the API ignored the filter
+	for _, conferenceAlias := range aliases {
+		if conferenceAlias.Alias != alias.ValueString() {
+			continue
+		}
+		var conference config.Conference
+		if err := client.GetJSON(ctx, strings.TrimPrefix(conferenceAlias.Conference, "/api/admin/"), nil, &conference); err != nil {
+			diags.AddError(
+				"Error Reading Infinity conference",
+				fmt.Sprintf("Could not read conference %s of alias '%s': %s", conferenceAlias.Conference, alias.ValueString(), err),
+			)
+			return nil
+		}
+		if !name.IsNull() && name.ValueString() != conference.Name {
+			diags.AddAttributeError(
+				path.Root("alias"),
+				"Conference Alias Mismatch",
+				fmt.Sprintf("Alias '%s' belongs to conference '%s', not '%s'.", alias.ValueString(), conference.Name, name.ValueString()),
+			)
+			return nil
+		}
+		return &conference.Name
+	}
+
+	diags.AddAttributeError(
+		path.Root("alias"),
+		"Conference Alias Not Found",
+		fmt.Sprintf("Conference alias '%s' not found.", alias.ValueString()),
+	)
+	return nil
+}
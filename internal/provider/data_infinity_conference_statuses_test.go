@@ -0,0 +1,223 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/pexip/go-infinity-sdk/v38/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+// mockLiveConferences mocks the live status of two conferences. If queries is
+// not nil, the query of the last request to each endpoint is recorded in it.
+func mockLiveConferences(client *infinity.ClientMock, queries map[string]url.Values) {
+	record := func(args mock.Arguments) {
+		if queries != nil {
+			queries[args.String(1)] = *args.Get(2).(*url.Values)
+		}
+	}
+	client.On("GetJSON", mock.Anything, "status/v1/conference/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		record(args)
+		list := args.Get(3).(*status.ConferenceListResponse)
+		list.Objects = []status.ConferenceStatus{
+			{
+				ID:          "11111111-1111-1111-1111-111111111111",
+				Name:        "weekly-standup",
+				ServiceType: "conference",
+				IsStarted:   true,
+				StartTime:   &util.InfinityTime{Time: time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)},
+				ResourceURI: "/api/admin/status/v1/conference/11111111-1111-1111-1111-111111111111/",
+			},
+			{
+				ID:          "22222222-2222-2222-2222-222222222222",
+				Name:        "all-hands",
+				ServiceType: "conference",
+				ResourceURI: "/api/admin/status/v1/conference/22222222-2222-2222-2222-222222222222/",
+			},
+		}
+	}).Maybe()
+	client.On("GetJSON", mock.Anything, "status/v1/participant/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		record(args)
+		list := args.Get(3).(*status.ParticipantListResponse)
+		list.Objects = []status.Participant{
+			{
+				ID:               "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				Conference:       "weekly-standup",
+				DisplayName:      "Alice",
+				DestinationAlias: "standup@example.com",
+				Role:             "chair",
+				Protocol:         "WebRTC",
+				CallDirection:    "in",
+				CallQuality:      "1_good",
+				MediaNode:        "10.0.0.11",
+			},
+			{
+				ID:            "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+				Conference:    "weekly-standup",
+				DisplayName:   "Recorder",
+				Role:          "guest",
+				Protocol:      "SIP",
+				CallDirection: "out",
+				CallQuality:   "1_good",
+				MediaNode:     "10.0.0.11",
+				IsRecording:   true,
+			},
+			{
+				ID:            "cccccccc-cccc-cccc-cccc-cccccccccccc",
+				Conference:    "all-hands",
+				DisplayName:   "Stream",
+				Role:          "guest",
+				Protocol:      "RTMP",
+				CallDirection: "out",
+				CallQuality:   "2_ok",
+				MediaNode:     "10.0.0.12",
+				IsStreaming:   true,
+			},
+		}
+	}).Maybe()
+	client.On("GetJSON", mock.Anything, "configuration/v1/conference_alias/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		record(args)
+		list := args.Get(3).(*config.ConferenceAliasListResponse)
+		list.Objects = []config.ConferenceAlias{
+			{ID: 1, Alias: "standup@example.com", Conference: "/api/admin/configuration/v1/conference/1/"},
+			{ID: 2, Alias: "allhands@example.com", Conference: "/api/admin/configuration/v1/conference/2/"},
+		}
+	}).Maybe()
+	client.On("GetJSON", mock.Anything, "configuration/v1/conference/1/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		conference := args.Get(3).(*config.Conference)
+		conference.Name = "weekly-standup"
+	}).Maybe()
+}
+
+func TestInfinityConferenceStatuses(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockLiveConferences(client, nil)
+
+	testInfinityConferenceStatuses(t, client)
+}
+
+func testInfinityConferenceStatuses(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "data_infinity_conference_statuses_basic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_statuses.all", "conferences.#", "2"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_statuses.standup", "conferences.#", "1"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_statuses.standup", "conferences.0.name", "weekly-standup"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_statuses.standup", "conferences.0.is_started", "true"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_conference_statuses.standup", "conferences.0.participant_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestInfinityConferenceStatusesDataSource_Read(t *testing.T) {
+	client := infinity.NewClientMock()
+	queries := map[string]url.Values{}
+	mockLiveConferences(client, queries)
+	d := &InfinityConferenceStatusesDataSource{InfinityClient: client}
+
+	tests := []struct {
+		name         string
+		attrs        map[string]tftypes.Value
+		conferences  []string
+		participants []int32
+		// nameFilter is the conference name the status API is filtered by
+		nameFilter string
+		wantErr    string
+	}{
+		{
+			name:         "all",
+			conferences:  []string{"weekly-standup", "all-hands"},
+			participants: []int32{2, 1},
+		},
+		{
+			name:         "name",
+			attrs:        map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "all-hands")},
+			conferences:  []string{"all-hands"},
+			participants: []int32{1},
+			nameFilter:   "all-hands",
+		},
+		{
+			name:         "alias",
+			attrs:        map[string]tftypes.Value{"alias": tftypes.NewValue(tftypes.String, "standup@example.com")},
+			conferences:  []string{"weekly-standup"},
+			participants: []int32{2},
+			nameFilter:   "weekly-standup",
+		},
+		{
+			name: "alias of another conference",
+			attrs: map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "all-hands"),
+				"alias": tftypes.NewValue(tftypes.String, "standup@example.com"),
+			},
+			wantErr: "Conference Alias Mismatch",
+		},
+		{
+			name:    "unknown alias",
+			attrs:   map[string]tftypes.Value{"alias": tftypes.NewValue(tftypes.String, "nobody@example.com")},
+			wantErr: "Conference Alias Not Found",
+		},
+		{
+			name:         "not live",
+			attrs:        map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "quarterly-review")},
+			conferences:  []string{},
+			participants: []int32{},
+			nameFilter:   "quarterly-review",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clear(queries)
+			state, diags := readTestDataSource(t, d, tt.attrs)
+			if alias, ok := tt.attrs["alias"]; ok {
+				var want string
+				require.NoError(t, alias.As(&want))
+				require.Equal(t, want, queries["configuration/v1/conference_alias/"].Get("alias"))
+			}
+			if tt.wantErr != "" {
+				require.True(t, diags.HasError())
+				require.Equal(t, tt.wantErr, diags.Errors()[0].Summary())
+				return
+			}
+			require.False(t, diags.HasError(), "%v", diags)
+			require.Equal(t, tt.nameFilter, queries["status/v1/conference/"].Get("name"))
+			require.Equal(t, tt.nameFilter, queries["status/v1/participant/"].Get("conference"))
+
+			var model InfinityConferenceStatusesModel
+			require.False(t, state.Get(t.Context(), &model).HasError())
+			names := []string{}
+			participants := []int32{}
+			for _, conference := range model.Conferences {
+				names = append(names, conference.Name.ValueString())
+				participants = append(participants, conference.ParticipantCount.ValueInt32())
+			}
+			require.Equal(t, tt.conferences, names)
+			require.Equal(t, tt.participants, participants)
+		})
+	}
+}
@@ -0,0 +1,287 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+)
+
+// licenceDateLayouts are the formats the Manager uses for licence dates.
+var licenceDateLayouts = []string{
+	"2006-01-02",
+	"2-Jan-2006",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+}
+
+type InfinityLicenceUsageDataSource struct {
+	InfinityClient InfinityClient
+
+	now func() time.Time
+}
+
+type InfinityLicenceUsageModel struct {
+	ID           types.String                       `tfsdk:"id"`
+	Port         *InfinityLicenceUsageCountModel    `tfsdk:"port"`
+	VMR          *InfinityLicenceUsageCountModel    `tfsdk:"vmr"`
+	Audio        *InfinityLicenceUsageCountModel    `tfsdk:"audio"`
+	System       *InfinityLicenceUsageCountModel    `tfsdk:"system"`
+	Teams        *InfinityLicenceUsageCountModel    `tfsdk:"teams"`
+	Overdraft    types.Bool                         `tfsdk:"overdraft"`
+	DaysToExpiry types.Int64                        `tfsdk:"days_to_expiry"`
+	Licences     []InfinityLicenceUsageLicenceModel `tfsdk:"licences"`
+}
+
+type InfinityLicenceUsageCountModel struct {
+	Used      types.Int64 `tfsdk:"used"`
+	Total     types.Int64 `tfsdk:"total"`
+	Available types.Int64 `tfsdk:"available"`
+	Overdraft types.Bool  `tfsdk:"overdraft"`
+}
+
+type InfinityLicenceUsageLicenceModel struct {
+	EntitlementID       types.String `tfsdk:"entitlement_id"`
+	FulfillmentID       types.String `tfsdk:"fulfillment_id"`
+	ProductID           types.String `tfsdk:"product_id"`
+	Features            types.String `tfsdk:"features"`
+	Status              types.String `tfsdk:"status"`
+	Concurrent          types.Int64  `tfsdk:"concurrent"`
+	ConcurrentOverdraft types.Int64  `tfsdk:"concurrent_overdraft"`
+	ExpirationDate      types.String `tfsdk:"expiration_date"`
+	DaysToExpiry        types.Int64  `tfsdk:"days_to_expiry"`
+	Expired             types.Bool   `tfsdk:"expired"`
+}
+
+func (d *InfinityLicenceUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_licence_usage"
+}
+
+func (d *InfinityLicenceUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+	d.InfinityClient = p.client
+}
+
+func licenceUsageCountAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"used": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of licences in use.",
+			},
+			"total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of licences installed.",
+			},
+			"available": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of licences that are not in use. Zero when in overdraft.",
+			},
+			"overdraft": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether more licences are in use than are installed.",
+			},
+		},
+		MarkdownDescription: description,
+	}
+}
+
+func (d *InfinityLicenceUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the data source.",
+			},
+			"port":   licenceUsageCountAttribute("Usage of call (port) licences."),
+			"vmr":    licenceUsageCountAttribute("Usage of Virtual Meeting Room licences."),
+			"audio":  licenceUsageCountAttribute("Usage of audio-only call licences."),
+			"system": licenceUsageCountAttribute("Usage of system (Conferencing Node) licences."),
+			"teams":  licenceUsageCountAttribute("Usage of Microsoft Teams Connector licences."),
+			"overdraft": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether any licence type is in overdraft.",
+			},
+			"days_to_expiry": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Days until the first of the installed licences expires, negative if one has expired. Null if no licence has an expiration date.",
+			},
+			"licences": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entitlement_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The entitlement ID of the licence.",
+						},
+						"fulfillment_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The fulfillment ID of the licence.",
+						},
+						"product_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The product ID of the licence.",
+						},
+						"features": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The features the licence enables.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the licence.",
+						},
+						"concurrent": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of concurrent licences.",
+						},
+						"concurrent_overdraft": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of concurrent overdraft licences.",
+						},
+						"expiration_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The expiration date of the licence, as reported by the Manager.",
+						},
+						"days_to_expiry": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Days until the licence expires, negative if it has expired. Null if the licence does not expire.",
+						},
+						"expired": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the licence has expired.",
+						},
+					},
+				},
+				MarkdownDescription: "The installed licences.",
+			},
+		},
+		MarkdownDescription: "Reads the current licence usage from the Infinity status API, together with the expiry of the installed licences.",
+	}
+}
+
+func (d *InfinityLicenceUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InfinityLicenceUsageModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usage, err := d.InfinityClient.Status().GetLicensing(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity licensing status",
+			fmt.Sprintf("Could not read licensing status: %s", err),
+		)
+		return
+	}
+
+	licences, err := listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]config.Licence, string, error) {
+		page, err := d.InfinityClient.Config().ListLicences(ctx, &config.ListOptions{BaseListOptions: *opts})
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Infinity licences",
+			fmt.Sprintf("Could not list licences: %s", err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("/api/admin/status/v1/licensing/")
+	state.Port = licenceUsageCount(usage.PortCount, usage.PortTotal)
+	state.VMR = licenceUsageCount(usage.VMRCount, usage.VMRTotal)
+	state.Audio = licenceUsageCount(usage.AudioCount, usage.AudioTotal)
+	state.System = licenceUsageCount(usage.SystemCount, usage.SystemTotal)
+	state.Teams = licenceUsageCount(usage.TeamsCount, usage.TeamsTotal)
+	overdraft := false
+	for _, count := range []*InfinityLicenceUsageCountModel{state.Port, state.VMR, state.Audio, state.System, state.Teams} {
+		overdraft = overdraft || count.Overdraft.ValueBool()
+	}
+	state.Overdraft = types.BoolValue(overdraft)
+
+	now := time.Now
+	if d.now != nil {
+		now = d.now
+	}
+	state.DaysToExpiry = types.Int64Null()
+	state.Licences = []InfinityLicenceUsageLicenceModel{}
+	for _, licence := range licences {
+		model := InfinityLicenceUsageLicenceModel{
+			EntitlementID:       types.StringValue(licence.EntitlementID),
+			FulfillmentID:       types.StringValue(licence.FulfillmentID),
+			ProductID:           types.StringValue(licence.ProductID),
+			Features:            types.StringValue(licence.Features),
+			Status:              types.StringValue(licence.Status),
+			Concurrent:          types.Int64Value(int64(licence.Concurrent)),
+			ConcurrentOverdraft: types.Int64Value(int64(licence.ConcurrentOverdraft)),
+			ExpirationDate:      types.StringValue(licence.ExpirationDate),
+			DaysToExpiry:        types.Int64Null(),
+			Expired:             types.BoolValue(false),
+		}
+		if days, ok := daysToExpiry(licence.ExpirationDate, now()); ok {
+			model.DaysToExpiry = types.Int64Value(days)
+			model.Expired = types.BoolValue(days < 0)
+			if state.DaysToExpiry.IsNull() || days < state.DaysToExpiry.ValueInt64() {
+				state.DaysToExpiry = types.Int64Value(days)
+			}
+		}
+		state.Licences = append(state.Licences, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func licenceUsageCount(used, total int) *InfinityLicenceUsageCountModel {
+	return &InfinityLicenceUsageCountModel{
+		Used:      types.Int64Value(int64(used)),
+		Total:     types.Int64Value(int64(total)),
+		Available: types.Int64Value(int64(max(total-used, 0))),
+		Overdraft: types.BoolValue(used > total),
+	}
+}
+
+// daysToExpiry returns the number of whole days from now until the licence
+// expiration date, which is negative once the licence has expired. It returns
+// false for licences that do not expire, or whose date cannot be parsed.
+func daysToExpiry(expirationDate string, now time.Time) (int64, bool) {
+	if expirationDate == "" || strings.EqualFold(expirationDate, "permanent") {
+		return 0, false
+	}
+	for _, layout := range licenceDateLayouts {
+		expiry, err := time.Parse(layout, expirationDate)
+		if err != nil {
+			continue
+		}
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		day := time.Date(expiry.Year(), expiry.Month(), expiry.Day(), 0, 0, 0, 0, time.UTC)
+		return int64(day.Sub(today) / (24 * time.Hour)), true
+	}
+	return 0, false
+}
@@ -0,0 +1,125 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+func mockLicenceUsage(client *infinity.ClientMock) {
+	client.On("GetJSON", mock.Anything, "status/v1/licensing/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		licensing := args.Get(3).(*status.LicensingResponse)
+		licensing.Objects = []status.Licensing{{
+			PortCount:   120,
+			PortTotal:   100,
+			VMRCount:    40,
+			VMRTotal:    500,
+			AudioCount:  3,
+			AudioTotal:  50,
+			SystemCount: 4,
+			SystemTotal: 10,
+		}}
+	}).Maybe()
+	client.On("GetJSON", mock.Anything, "configuration/v1/licence/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		licences := args.Get(3).(*config.LicenceListResponse)
+		licences.Objects = []config.Licence{
+			{EntitlementID: "ent-ports", FulfillmentID: "ful-1", Concurrent: 100, ExpirationDate: "2025-07-31", Status: "Activated"},
+			{EntitlementID: "ent-vmr", FulfillmentID: "ful-2", ExpirationDate: "permanent", Status: "Activated"},
+			{EntitlementID: "ent-system", FulfillmentID: "ful-3", ExpirationDate: "15-jun-2025", Status: "Activated"},
+		}
+	}).Maybe()
+}
+
+func TestInfinityLicenceUsage(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockLicenceUsage(client)
+
+	testInfinityLicenceUsage(t, client)
+}
+
+func testInfinityLicenceUsage(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "data_infinity_licence_usage_basic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pexip_infinity_licence_usage.usage", "port.used", "120"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_licence_usage.usage", "port.overdraft", "true"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_licence_usage.usage", "vmr.available", "460"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_licence_usage.usage", "overdraft", "true"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_licence_usage.usage", "licences.#", "3"),
+					resource.TestCheckResourceAttrSet("data.pexip_infinity_licence_usage.usage", "days_to_expiry"),
+				),
+			},
+		},
+	})
+}
+
+func TestInfinityLicenceUsageDataSource_Read(t *testing.T) {
+	client := infinity.NewClientMock()
+	mockLicenceUsage(client)
+	d := &InfinityLicenceUsageDataSource{
+		InfinityClient: client,
+		now:            func() time.Time { return time.Date(2025, 6, 1, 23, 30, 0, 0, time.UTC) },
+	}
+
+	state, diags := readTestDataSource(t, d, nil)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	var model InfinityLicenceUsageModel
+	require.False(t, state.Get(t.Context(), &model).HasError())
+
+	require.Equal(t, int64(120), model.Port.Used.ValueInt64())
+	require.Equal(t, int64(0), model.Port.Available.ValueInt64())
+	require.True(t, model.Port.Overdraft.ValueBool())
+	require.Equal(t, int64(460), model.VMR.Available.ValueInt64())
+	require.False(t, model.VMR.Overdraft.ValueBool())
+	require.Equal(t, int64(0), model.Teams.Total.ValueInt64())
+	require.True(t, model.Overdraft.ValueBool())
+
+	require.Len(t, model.Licences, 3)
+	require.Equal(t, int64(60), model.Licences[0].DaysToExpiry.ValueInt64())
+	require.True(t, model.Licences[1].DaysToExpiry.IsNull())
+	require.False(t, model.Licences[1].Expired.ValueBool())
+	require.Equal(t, int64(14), model.Licences[2].DaysToExpiry.ValueInt64())
+	require.Equal(t, int64(14), model.DaysToExpiry.ValueInt64())
+}
+
+func TestDaysToExpiry(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	days, ok := daysToExpiry("2025-06-01", now)
+	require.True(t, ok)
+	require.Equal(t, int64(0), days)
+
+	days, ok = daysToExpiry("28-May-2025", now)
+	require.True(t, ok)
+	require.Equal(t, int64(-4), days)
+
+	_, ok = daysToExpiry("Permanent", now)
+	require.False(t, ok)
+	_, ok = daysToExpiry("", now)
+	require.False(t, ok)
+	_, ok = daysToExpiry("soon", now)
+	require.False(t, ok)
+}
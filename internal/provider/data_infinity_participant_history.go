@@ -0,0 +1,267 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pexip/go-infinity-sdk/v38/history"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+)
+
+// participantHistory is a participant history record. The SDK model does not
+// include the protocol of the call or the tag of the conference, so they are
+// added here.
+type participantHistory struct {
+	history.Participant
+	Protocol   string `json:"protocol"`
+	ServiceTag string `json:"service_tag"`
+}
+
+type participantHistoryListResponse struct {
+	Meta struct {
+		Next string `json:"next"`
+	} `json:"meta"`
+	Objects []participantHistory `json:"objects"`
+}
+
+type InfinityParticipantHistoryDataSource struct {
+	InfinityClient InfinityClient
+}
+
+type InfinityParticipantHistoryModel struct {
+	ID             types.String                            `tfsdk:"id"`
+	StartTime      types.String                            `tfsdk:"start_time"`
+	EndTime        types.String                            `tfsdk:"end_time"`
+	ConferenceName types.String                            `tfsdk:"conference_name"`
+	Tag            types.String                            `tfsdk:"tag"`
+	Participants   []InfinityParticipantHistoryRecordModel `tfsdk:"participants"`
+}
+
+type InfinityParticipantHistoryRecordModel struct {
+	ID               types.String `tfsdk:"id"`
+	ResourceID       types.Int32  `tfsdk:"resource_id"`
+	ConferenceName   types.String `tfsdk:"conference_name"`
+	Tag              types.String `tfsdk:"tag"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	LocalAlias       types.String `tfsdk:"local_alias"`
+	RemoteAlias      types.String `tfsdk:"remote_alias"`
+	RemoteAddress    types.String `tfsdk:"remote_address"`
+	Role             types.String `tfsdk:"role"`
+	Protocol         types.String `tfsdk:"protocol"`
+	CallDirection    types.String `tfsdk:"call_direction"`
+	Vendor           types.String `tfsdk:"vendor"`
+	StartTime        types.String `tfsdk:"start_time"`
+	EndTime          types.String `tfsdk:"end_time"`
+	DurationSeconds  types.Int64  `tfsdk:"duration_seconds"`
+	DisconnectReason types.String `tfsdk:"disconnect_reason"`
+	MediaNode        types.String `tfsdk:"media_node"`
+	SignalingNode    types.String `tfsdk:"signaling_node"`
+}
+
+func (d *InfinityParticipantHistoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_participant_history"
+}
+
+func (d *InfinityParticipantHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+	d.InfinityClient = p.client
+}
+
+func (d *InfinityParticipantHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the data source.",
+			},
+			"start_time": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return participants that joined at or after this time, in RFC 3339 format, e.g. `2025-06-01T00:00:00Z`.",
+			},
+			"end_time": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return participants that left before this time, in RFC 3339 format.",
+			},
+			"conference_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return participants of the conference with this name.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return participants of conferences with this tag.",
+			},
+			"participants": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Resource URI of the participant history record in Infinity.",
+						},
+						"resource_id": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The resource integer identifier of the participant history record in Infinity.",
+						},
+						"conference_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the conference the participant joined.",
+						},
+						"tag": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The tag of the conference the participant joined.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The display name of the participant.",
+						},
+						"local_alias": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The alias the participant dialed.",
+						},
+						"remote_alias": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The alias of the participant.",
+						},
+						"remote_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The IP address of the participant.",
+						},
+						"role": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The role of the participant, e.g. `chair` or `guest`.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The call protocol, e.g. `SIP`, `H323`, `WebRTC` or `MSSIP`.",
+						},
+						"call_direction": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the call was dialed in (`in`) or out (`out`).",
+						},
+						"vendor": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The vendor of the participant's endpoint or client.",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the participant joined, in RFC 3339 format.",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the participant left, in RFC 3339 format.",
+						},
+						"duration_seconds": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "How long the participant was in the conference, in seconds.",
+						},
+						"disconnect_reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Why the participant left, e.g. `Call disconnected`.",
+						},
+						"media_node": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The Conferencing Node that handled the media of the call.",
+						},
+						"signaling_node": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The Conferencing Node that handled the signaling of the call.",
+						},
+					},
+				},
+				MarkdownDescription: "The participants that match the filters.",
+			},
+		},
+		MarkdownDescription: "Reads past participants from the Infinity history API, e.g. to report how a Virtual Meeting Room is used.",
+	}
+}
+
+func (d *InfinityParticipantHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InfinityParticipantHistoryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := parseHistoryWindow(state.StartTime, state.EndTime, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	participants, err := listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]participantHistory, string, error) {
+		params := window.listOptions(opts, "").ToURLValues()
+		if !state.ConferenceName.IsNull() {
+			params.Set("conference_name", state.ConferenceName.ValueString())
+		}
+		if !state.Tag.IsNull() {
+			params.Set("service_tag", state.Tag.ValueString())
+		}
+		var page participantHistoryListResponse
+		if err := d.InfinityClient.GetJSON(ctx, "history/v1/participant/", &params, &page); err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity participant history",
+			fmt.Sprintf("Could not list participant history: %s", err),
+		)
+		return
+	}
+
+	state.Participants = []InfinityParticipantHistoryRecordModel{}
+	for _, participant := range participants {
+		if !window.contains(participant.StartTime.Time, participant.EndTime.Time) {
+			continue
+		}
+		if !state.ConferenceName.IsNull() && participant.ConferenceName != state.ConferenceName.ValueString() {
+			continue
+		}
+		if !state.Tag.IsNull() && participant.ServiceTag != state.Tag.ValueString() {
+			continue
+		}
+		state.Participants = append(state.Participants, InfinityParticipantHistoryRecordModel{
+			ID:               types.StringValue(participant.ResourceURI),
+			ResourceID:       types.Int32Value(int32(participant.ID)), // #nosec G115 -- API values are expected to be within int32 range
+			ConferenceName:   types.StringValue(participant.ConferenceName),
+			Tag:              types.StringValue(participant.ServiceTag),
+			DisplayName:      types.StringValue(participant.DisplayName),
+			LocalAlias:       types.StringValue(participant.LocalAlias),
+			RemoteAlias:      types.StringValue(participant.RemoteAlias),
+			RemoteAddress:    types.StringValue(participant.RemoteAddress),
+			Role:             types.StringValue(participant.Role),
+			Protocol:         types.StringValue(participant.Protocol),
+			CallDirection:    types.StringValue(participant.CallDirection),
+			Vendor:           types.StringValue(participant.Vendor),
+			StartTime:        timeValue(&participant.StartTime),
+			EndTime:          timeValue(&participant.EndTime),
+			DurationSeconds:  types.Int64Value(int64(participant.DurationSeconds)),
+			DisconnectReason: types.StringValue(participant.DisconnectReason),
+			MediaNode:        types.StringValue(participant.MediaNode),
+			SignalingNode:    types.StringValue(participant.SignalingNode),
+		})
+	}
+	state.ID = types.StringValue("/api/admin/history/v1/participant/")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
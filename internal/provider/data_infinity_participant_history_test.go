@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/history"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+func mockParticipantHistory(client *infinity.ClientMock, query *url.Values) {
+	client.On("GetJSON", mock.Anything, "history/v1/participant/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		if query != nil {
+			*query = *args.Get(2).(*url.Values)
+		}
+		list := args.Get(3).(*participantHistoryListResponse)
+		list.Objects = []participantHistory{
+			{
+				Participant: history.Participant{
+					ID:               1,
+					ConferenceName:   "weekly-standup",
+					DisplayName:      "Alice",
+					Role:             "chair",
+					CallDirection:    "in",
+					StartTime:        historyTime(2, 9),
+					EndTime:          historyTime(2, 10),
+					DurationSeconds:  3600,
+					DisconnectReason: "Call disconnected",
+					ResourceURI:      "/api/admin/history/v1/participant/1/",
+				},
+				Protocol:   "WebRTC",
+				ServiceTag: "team",
+			},
+			{
+				Participant: history.Participant{
+					ID:               2,
+					ConferenceName:   "all-hands",
+					DisplayName:      "Bob",
+					Role:             "guest",
+					CallDirection:    "in",
+					StartTime:        historyTime(3, 15),
+					EndTime:          historyTime(3, 16),
+					DurationSeconds:  3540,
+					DisconnectReason: "Conference terminated",
+					ResourceURI:      "/api/admin/history/v1/participant/2/",
+				},
+				Protocol:   "SIP",
+				ServiceTag: "company",
+			},
+		}
+	}).Maybe()
+}
+
+func TestInfinityParticipantHistory(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockParticipantHistory(client, nil)
+
+	testInfinityParticipantHistory(t, client)
+}
+
+func testInfinityParticipantHistory(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "data_infinity_participant_history_basic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pexip_infinity_participant_history.all", "participants.#", "2"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_participant_history.weekly", "participants.#", "1"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_participant_history.weekly", "participants.0.protocol", "WebRTC"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_participant_history.weekly", "participants.0.disconnect_reason", "Call disconnected"),
+				),
+			},
+		},
+	})
+}
+
+func TestInfinityParticipantHistoryDataSource_Read(t *testing.T) {
+	client := infinity.NewClientMock()
+	var query url.Values
+	mockParticipantHistory(client, &query)
+	d := &InfinityParticipantHistoryDataSource{InfinityClient: client}
+
+	state, diags := readTestDataSource(t, d, map[string]tftypes.Value{
+		"conference_name": tftypes.NewValue(tftypes.String, "all-hands"),
+		"start_time":      tftypes.NewValue(tftypes.String, "2025-06-01T00:00:00Z"),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, "all-hands", query.Get("conference_name"))
+	require.Equal(t, "2025-06-01T00:00:00Z", query.Get("start_time__gte"))
+
+	var model InfinityParticipantHistoryModel
+	require.False(t, state.Get(t.Context(), &model).HasError())
+	require.Len(t, model.Participants, 1)
+	participant := model.Participants[0]
+	require.Equal(t, "Bob", participant.DisplayName.ValueString())
+	require.Equal(t, "SIP", participant.Protocol.ValueString())
+	require.Equal(t, "Conference terminated", participant.DisconnectReason.ValueString())
+	require.Equal(t, int64(3540), participant.DurationSeconds.ValueInt64())
+	require.Equal(t, "2025-06-03T16:00:00Z", participant.EndTime.ValueString())
+	require.Equal(t, "company", participant.Tag.ValueString())
+
+	state, diags = readTestDataSource(t, d, map[string]tftypes.Value{
+		"tag": tftypes.NewValue(tftypes.String, "team"),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, "team", query.Get("service_tag"))
+	require.False(t, state.Get(t.Context(), &model).HasError())
+	require.Len(t, model.Participants, 1)
+	require.Equal(t, "Alice", model.Participants[0].DisplayName.ValueString())
+}
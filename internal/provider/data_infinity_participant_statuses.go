@@ -0,0 +1,244 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type InfinityParticipantStatusesDataSource struct {
+	InfinityClient InfinityClient
+}
+
+type InfinityParticipantStatusesModel struct {
+	ID             types.String                     `tfsdk:"id"`
+	ConferenceName types.String                     `tfsdk:"conference_name"`
+	Alias          types.String                     `tfsdk:"alias"`
+	Participants   []InfinityParticipantStatusModel `tfsdk:"participants"`
+}
+
+type InfinityParticipantStatusModel struct {
+	ID               types.String `tfsdk:"id"`
+	ConferenceName   types.String `tfsdk:"conference_name"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	SourceAlias      types.String `tfsdk:"source_alias"`
+	DestinationAlias types.String `tfsdk:"destination_alias"`
+	RemoteAddress    types.String `tfsdk:"remote_address"`
+	Role             types.String `tfsdk:"role"`
+	Protocol         types.String `tfsdk:"protocol"`
+	CallDirection    types.String `tfsdk:"call_direction"`
+	CallQuality      types.String `tfsdk:"call_quality"`
+	ConnectTime      types.String `tfsdk:"connect_time"`
+	MediaNode        types.String `tfsdk:"media_node"`
+	SignalingNode    types.String `tfsdk:"signaling_node"`
+	SystemLocation   types.String `tfsdk:"system_location"`
+	Encryption       types.String `tfsdk:"encryption"`
+	Vendor           types.String `tfsdk:"vendor"`
+	IsStreaming      types.Bool   `tfsdk:"is_streaming"`
+	IsRecording      types.Bool   `tfsdk:"is_recording"`
+	IsTranscribing   types.Bool   `tfsdk:"is_transcribing"`
+	IsPresenting     types.Bool   `tfsdk:"is_presenting"`
+	IsMuted          types.Bool   `tfsdk:"is_muted"`
+	IsOnHold         types.Bool   `tfsdk:"is_on_hold"`
+}
+
+func (d *InfinityParticipantStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_participant_statuses"
+}
+
+func (d *InfinityParticipantStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+	d.InfinityClient = p.client
+}
+
+func (d *InfinityParticipantStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the data source.",
+			},
+			"conference_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return participants of the conference with this name.",
+			},
+			"alias": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return participants of the conference that this alias belongs to.",
+			},
+			"participants": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the participant.",
+						},
+						"conference_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the conference the participant is in.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The display name of the participant.",
+						},
+						"source_alias": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The alias of the caller.",
+						},
+						"destination_alias": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The alias that was called.",
+						},
+						"remote_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The IP address of the participant.",
+						},
+						"role": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The role of the participant, e.g. `chair` or `guest`.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The call protocol, e.g. `SIP`, `H323`, `WebRTC`, `MSSIP` or `RTMP`.",
+						},
+						"call_direction": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the call was dialed in (`in`) or out (`out`).",
+						},
+						"call_quality": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The current quality of the call, e.g. `1_good` or `3_bad`.",
+						},
+						"connect_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the participant joined, in RFC 3339 format.",
+						},
+						"media_node": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The Conferencing Node that handles the media of the call.",
+						},
+						"signaling_node": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The Conferencing Node that handles the signaling of the call.",
+						},
+						"system_location": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The system location of the media node.",
+						},
+						"encryption": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the call is encrypted.",
+						},
+						"vendor": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The vendor of the participant's endpoint or client.",
+						},
+						"is_streaming": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the participant is a streaming participant.",
+						},
+						"is_recording": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the participant is a recording participant.",
+						},
+						"is_transcribing": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the participant is a transcribing participant.",
+						},
+						"is_presenting": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the participant is sending presentation content.",
+						},
+						"is_muted": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the participant is muted.",
+						},
+						"is_on_hold": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the participant is on hold.",
+						},
+					},
+				},
+				MarkdownDescription: "The participants that match the filters.",
+			},
+		},
+		MarkdownDescription: "Reads the participants that are in a conference from the Infinity status API.",
+	}
+}
+
+func (d *InfinityParticipantStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InfinityParticipantStatusesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conferenceName := liveConferenceFilter(ctx, d.InfinityClient, state.ConferenceName, state.Alias, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	participants, err := listParticipantStatuses(ctx, d.InfinityClient, conferenceName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity participant status",
+			fmt.Sprintf("Could not list participant statuses: %s", err),
+		)
+		return
+	}
+
+	state.Participants = []InfinityParticipantStatusModel{}
+	for _, participant := range participants {
+		// The conference is also checked here, in case the API ignored the filter
+		if conferenceName != nil && participant.Conference != *conferenceName {
+			continue
+		}
+		state.Participants = append(state.Participants, InfinityParticipantStatusModel{
+			ID:               types.StringValue(participant.ID),
+			ConferenceName:   types.StringValue(participant.Conference),
+			DisplayName:      types.StringValue(participant.DisplayName),
+			SourceAlias:      types.StringValue(participant.SourceAlias),
+			DestinationAlias: types.StringValue(participant.DestinationAlias),
+			RemoteAddress:    types.StringValue(participant.RemoteAddress),
+			Role:             types.StringValue(participant.Role),
+			Protocol:         types.StringValue(participant.Protocol),
+			CallDirection:    types.StringValue(participant.CallDirection),
+			CallQuality:      types.StringValue(participant.CallQuality),
+			ConnectTime:      timeValue(participant.ConnectTime),
+			MediaNode:        types.StringValue(participant.MediaNode),
+			SignalingNode:    types.StringValue(participant.SignallingNode),
+			SystemLocation:   types.StringValue(participant.SystemLocation),
+			Encryption:       types.StringValue(participant.Encryption),
+			Vendor:           types.StringValue(participant.Vendor),
+			IsStreaming:      types.BoolValue(participant.IsStreaming),
+			IsRecording:      types.BoolValue(participant.IsRecording),
+			IsTranscribing:   types.BoolValue(participant.IsTranscribing),
+			IsPresenting:     types.BoolValue(participant.IsPresenting),
+			IsMuted:          types.BoolValue(participant.IsMuted),
+			IsOnHold:         types.BoolValue(participant.IsOnHold),
+		})
+	}
+	state.ID = types.StringValue("/api/admin/status/v1/participant/")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
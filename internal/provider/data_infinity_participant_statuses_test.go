@@ -0,0 +1,100 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+func TestInfinityParticipantStatuses(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockLiveConferences(client, nil)
+
+	testInfinityParticipantStatuses(t, client)
+}
+
+func testInfinityParticipantStatuses(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "data_infinity_participant_statuses_basic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pexip_infinity_participant_statuses.all", "participants.#", "3"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_participant_statuses.standup", "participants.#", "2"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_participant_statuses.standup", "participants.0.protocol", "WebRTC"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_participant_statuses.standup", "participants.1.is_recording", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestInfinityParticipantStatusesDataSource_Read(t *testing.T) {
+	client := infinity.NewClientMock()
+	mockLiveConferences(client, nil)
+	d := &InfinityParticipantStatusesDataSource{InfinityClient: client}
+
+	tests := []struct {
+		name         string
+		attrs        map[string]tftypes.Value
+		participants []string
+	}{
+		{
+			name:         "all",
+			participants: []string{"Alice", "Recorder", "Stream"},
+		},
+		{
+			name:         "conference name",
+			attrs:        map[string]tftypes.Value{"conference_name": tftypes.NewValue(tftypes.String, "all-hands")},
+			participants: []string{"Stream"},
+		},
+		{
+			name:         "alias",
+			attrs:        map[string]tftypes.Value{"alias": tftypes.NewValue(tftypes.String, "standup@example.com")},
+			participants: []string{"Alice", "Recorder"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := readTestDataSource(t, d, tt.attrs)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			var model InfinityParticipantStatusesModel
+			require.False(t, state.Get(t.Context(), &model).HasError())
+			names := []string{}
+			for _, participant := range model.Participants {
+				names = append(names, participant.DisplayName.ValueString())
+			}
+			require.Equal(t, tt.participants, names)
+		})
+	}
+
+	state, diags := readTestDataSource(t, d, map[string]tftypes.Value{"conference_name": tftypes.NewValue(tftypes.String, "all-hands")})
+	require.False(t, diags.HasError(), "%v", diags)
+	var model InfinityParticipantStatusesModel
+	require.False(t, state.Get(t.Context(), &model).HasError())
+	stream := model.Participants[0]
+	require.Equal(t, "RTMP", stream.Protocol.ValueString())
+	require.Equal(t, "2_ok", stream.CallQuality.ValueString())
+	require.Equal(t, "10.0.0.12", stream.MediaNode.ValueString())
+	require.True(t, stream.IsStreaming.ValueBool())
+	require.False(t, stream.IsRecording.ValueBool())
+}
@@ -0,0 +1,236 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pexip/go-infinity-sdk/v38/status"
+)
+
+type InfinityWorkerVMStatusDataSource struct {
+	InfinityClient InfinityClient
+}
+
+type InfinityWorkerVMStatusModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ResourceID            types.Int32  `tfsdk:"resource_id"`
+	ConfigurationID       types.Int32  `tfsdk:"configuration_id"`
+	Name                  types.String `tfsdk:"name"`
+	NodeType              types.String `tfsdk:"node_type"`
+	SystemLocation        types.String `tfsdk:"system_location"`
+	SyncStatus            types.String `tfsdk:"sync_status"`
+	Version               types.String `tfsdk:"version"`
+	UpgradeStatus         types.String `tfsdk:"upgrade_status"`
+	DeployStatus          types.String `tfsdk:"deploy_status"`
+	BootTime              types.String `tfsdk:"boot_time"`
+	LastUpdated           types.String `tfsdk:"last_updated"`
+	MaintenanceMode       types.Bool   `tfsdk:"maintenance_mode"`
+	MaintenanceModeReason types.String `tfsdk:"maintenance_mode_reason"`
+	MediaLoad             types.Int32  `tfsdk:"media_load"`
+	MediaTokensUsed       types.Int32  `tfsdk:"media_tokens_used"`
+	MaxMediaTokens        types.Int32  `tfsdk:"max_media_tokens"`
+	SignalingCount        types.Int32  `tfsdk:"signaling_count"`
+	Hypervisor            types.String `tfsdk:"hypervisor"`
+	CPUCount              types.Int32  `tfsdk:"cpu_count"`
+	TotalRAM              types.Int32  `tfsdk:"total_ram"`
+}
+
+func (d *InfinityWorkerVMStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_worker_vm_status"
+}
+
+func (d *InfinityWorkerVMStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+	d.InfinityClient = p.client
+}
+
+// workerVMStatusAttributes returns the attributes of a Conferencing Node's
+// status, shared by the worker VM status data sources.
+func workerVMStatusAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Resource URI of the Conferencing Node status in Infinity.",
+		},
+		"resource_id": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The resource integer identifier of the Conferencing Node status in Infinity.",
+		},
+		"configuration_id": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The `resource_id` of the `pexip_infinity_worker_vm` that configures the Conferencing Node.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the Conferencing Node.",
+		},
+		"node_type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The role of the Conferencing Node, e.g. `CONFERENCING` or `PROXYING`.",
+		},
+		"system_location": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the system location of the Conferencing Node.",
+		},
+		"sync_status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the Conferencing Node has the latest configuration from the Manager, e.g. `SYNCED`.",
+		},
+		"version": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The software version the Conferencing Node is running.",
+		},
+		"upgrade_status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The status of the Conferencing Node's last software upgrade.",
+		},
+		"deploy_status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The deployment status of the Conferencing Node.",
+		},
+		"boot_time": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "When the Conferencing Node was last started, in RFC 3339 format.",
+		},
+		"last_updated": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "When the Conferencing Node last reported its status, in RFC 3339 format.",
+		},
+		"maintenance_mode": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the Conferencing Node is in maintenance mode and does not accept new calls.",
+		},
+		"maintenance_mode_reason": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Why the Conferencing Node was put in maintenance mode.",
+		},
+		"media_load": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The media load of the Conferencing Node, as a percentage of its capacity.",
+		},
+		"media_tokens_used": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of media tokens in use.",
+		},
+		"max_media_tokens": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of media tokens the Conferencing Node can handle.",
+		},
+		"signaling_count": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of signaling connections on the Conferencing Node.",
+		},
+		"hypervisor": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The hypervisor the Conferencing Node runs on.",
+		},
+		"cpu_count": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of CPU cores of the Conferencing Node.",
+		},
+		"total_ram": schema.Int32Attribute{
+			Computed:            true,
+			MarkdownDescription: "The amount of RAM of the Conferencing Node, in megabytes.",
+		},
+	}
+}
+
+func (d *InfinityWorkerVMStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := workerVMStatusAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The name of the Conferencing Node.",
+	}
+	resp.Schema = schema.Schema{
+		Attributes:          attributes,
+		MarkdownDescription: "Reads the live status of a Conferencing Node from the Infinity status API.",
+	}
+}
+
+func (d *InfinityWorkerVMStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InfinityWorkerVMStatusModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vms, err := listWorkerVMStatuses(ctx, d.InfinityClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity worker VM status",
+			fmt.Sprintf("Could not list worker VM statuses: %s", err),
+		)
+		return
+	}
+
+	for _, vm := range vms {
+		if vm.Name == state.Name.ValueString() {
+			state = workerVMStatusModel(vm)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Worker VM Not Found",
+		fmt.Sprintf("Worker VM with name '%s' not found in the status API. The node may not have been deployed yet.", state.Name.ValueString()),
+	)
+}
+
+// listWorkerVMStatuses returns the status of every Conferencing Node, reading
+// all pages of the status API.
+func listWorkerVMStatuses(ctx context.Context, client InfinityClient) ([]status.WorkerVM, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]status.WorkerVM, string, error) {
+		page, err := client.Status().ListWorkerVMs(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+}
+
+func workerVMStatusModel(vm status.WorkerVM) InfinityWorkerVMStatusModel {
+	return InfinityWorkerVMStatusModel{
+		ID:                    types.StringValue(vm.ResourceURI),
+		ResourceID:            types.Int32Value(int32(vm.ID)),              // #nosec G115 -- API values are expected to be within int32 range
+		ConfigurationID:       types.Int32Value(int32(vm.ConfigurationID)), // #nosec G115 -- API values are expected to be within int32 range
+		Name:                  types.StringValue(vm.Name),
+		NodeType:              types.StringValue(vm.NodeType),
+		SystemLocation:        types.StringValue(vm.SystemLocation),
+		SyncStatus:            types.StringValue(vm.SyncStatus),
+		Version:               types.StringValue(vm.Version),
+		UpgradeStatus:         types.StringValue(vm.UpgradeStatus),
+		DeployStatus:          types.StringValue(vm.DeployStatus),
+		BootTime:              timeValue(vm.BootTime),
+		LastUpdated:           timeValue(vm.LastUpdated),
+		MaintenanceMode:       types.BoolValue(vm.MaintenanceMode),
+		MaintenanceModeReason: types.StringValue(vm.MaintenanceModeReason),
+		MediaLoad:             types.Int32Value(int32(vm.MediaLoad)),       // #nosec G115 -- API values are expected to be within int32 range
+		MediaTokensUsed:       types.Int32Value(int32(vm.MediaTokensUsed)), // #nosec G115 -- API values are expected to be within int32 range
+		MaxMediaTokens:        types.Int32Value(int32(vm.MaxMediaTokens)),  // #nosec G115 -- API values are expected to be within int32 range
+		SignalingCount:        types.Int32Value(int32(vm.SignalingCount)),  // #nosec G115 -- API values are expected to be within int32 range
+		Hypervisor:            types.StringValue(vm.Hypervisor),
+		CPUCount:              types.Int32Value(int32(vm.CPUCount)), // #nosec G115 -- API values are expected to be within int32 range
+		TotalRAM:              types.Int32Value(int32(vm.TotalRAM)), // #nosec G115 -- API values are expected to be within int32 range
+	}
+}
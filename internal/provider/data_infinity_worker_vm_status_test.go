@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/pexip/go-infinity-sdk/v38/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pexip/terraform-provider-pexip/internal/test"
+)
+
+func mockWorkerVMStatuses(client *infinity.ClientMock) {
+	bootTime := &util.InfinityTime{Time: time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC)}
+
+	// Two pages, to check that every page is read
+	client.On("GetJSON", mock.Anything, "status/v1/worker_vm/", mock.MatchedBy(func(params interface{}) bool {
+		return params.(*url.Values).Get("offset") == ""
+	}), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		vms := args.Get(3).(*status.WorkerVMListResponse)
+		vms.Meta.Next = "/api/admin/status/v1/worker_vm/?limit=100&offset=2"
+		vms.Objects = []status.WorkerVM{
+			{
+				ID:              1,
+				ConfigurationID: 11,
+				Name:            "tf-test-worker-1",
+				NodeType:        "CONFERENCING",
+				SystemLocation:  "London",
+				SyncStatus:      "SYNCED",
+				Version:         "38.0.0",
+				BootTime:        bootTime,
+				MediaLoad:       25,
+				ResourceURI:     "/api/admin/status/v1/worker_vm/1/",
+			},
+			{
+				ID:                    2,
+				Name:                  "tf-test-worker-2",
+				NodeType:              "CONFERENCING",
+				SystemLocation:        "Oslo",
+				SyncStatus:            "SYNCING",
+				MaintenanceMode:       true,
+				MaintenanceModeReason: "Upgrading host",
+				ResourceURI:           "/api/admin/status/v1/worker_vm/2/",
+			},
+		}
+	}).Maybe()
+	client.On("GetJSON", mock.Anything, "status/v1/worker_vm/", mock.MatchedBy(func(params interface{}) bool {
+		return params.(*url.Values).Get("offset") == "2"
+	}), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		vms := args.Get(3).(*status.WorkerVMListResponse)
+		vms.Objects = []status.WorkerVM{
+			{
+				ID:             3,
+				Name:           "tf-test-worker-3",
+				NodeType:       "PROXYING",
+				SystemLocation: "London",
+				SyncStatus:     "SYNCED",
+				ResourceURI:    "/api/admin/status/v1/worker_vm/3/",
+			},
+		}
+	}).Maybe()
+}
+
+func TestInfinityWorkerVMStatus(t *testing.T) {
+	t.Parallel()
+	_ = os.Setenv("TF_ACC", "1")
+
+	// Create a mock client and set up expectations
+	client := infinity.NewClientMock()
+	mockWorkerVMStatuses(client)
+
+	testInfinityWorkerVMStatus(t, client)
+}
+
+func testInfinityWorkerVMStatus(t *testing.T, client InfinityClient) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: getTestProtoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: test.LoadTestFolder(t, "data_infinity_worker_vm_status_basic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pexip_infinity_worker_vm_status.worker", "sync_status", "SYNCED"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_worker_vm_status.worker", "version", "38.0.0"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_worker_vm_status.worker", "boot_time", "2025-06-01T08:30:00Z"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_worker_vm_status.worker", "media_load", "25"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_worker_vm_status.worker", "maintenance_mode", "false"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_worker_vm_statuses.london", "worker_vms.#", "2"),
+					resource.TestCheckResourceAttr("data.pexip_infinity_worker_vm_statuses.london", "worker_vms.1.name", "tf-test-worker-3"),
+				),
+			},
+		},
+	})
+}
+
+func TestInfinityWorkerVMStatusDataSource_Read(t *testing.T) {
+	client := infinity.NewClientMock()
+	mockWorkerVMStatuses(client)
+	d := &InfinityWorkerVMStatusDataSource{InfinityClient: client}
+
+	state, diags := readTestDataSource(t, d, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "tf-test-worker-2"),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	var model InfinityWorkerVMStatusModel
+	require.False(t, state.Get(t.Context(), &model).HasError())
+	require.Equal(t, "/api/admin/status/v1/worker_vm/2/", model.ID.ValueString())
+	require.Equal(t, "SYNCING", model.SyncStatus.ValueString())
+	require.True(t, model.MaintenanceMode.ValueBool())
+	require.Equal(t, "Upgrading host", model.MaintenanceModeReason.ValueString())
+	require.True(t, model.BootTime.IsNull())
+
+	_, diags = readTestDataSource(t, d, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "missing"),
+	})
+	require.Len(t, diags.Errors(), 1)
+	require.Equal(t, "Worker VM Not Found", diags.Errors()[0].Summary())
+}
+
+func TestInfinityWorkerVMStatusesDataSource_Read(t *testing.T) {
+	client := infinity.NewClientMock()
+	mockWorkerVMStatuses(client)
+	d := &InfinityWorkerVMStatusesDataSource{InfinityClient: client}
+
+	state, diags := readTestDataSource(t, d, nil)
+	require.False(t, diags.HasError(), "%v", diags)
+	var model InfinityWorkerVMStatusesModel
+	require.False(t, state.Get(t.Context(), &model).HasError())
+	require.Len(t, model.WorkerVMs, 3)
+
+	state, diags = readTestDataSource(t, d, map[string]tftypes.Value{
+		"system_location": tftypes.NewValue(tftypes.String, "London"),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+	require.False(t, state.Get(t.Context(), &model).HasError())
+	require.Len(t, model.WorkerVMs, 2)
+	require.Equal(t, types.StringValue("tf-test-worker-1"), model.WorkerVMs[0].Name)
+	require.Equal(t, types.StringValue("tf-test-worker-3"), model.WorkerVMs[1].Name)
+	require.Equal(t, types.StringValue("2025-06-01T08:30:00Z"), model.WorkerVMs[0].BootTime)
+}
@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type InfinityWorkerVMStatusesDataSource struct {
+	InfinityClient InfinityClient
+}
+
+type InfinityWorkerVMStatusesModel struct {
+	ID             types.String                  `tfsdk:"id"`
+	SystemLocation types.String                  `tfsdk:"system_location"`
+	WorkerVMs      []InfinityWorkerVMStatusModel `tfsdk:"worker_vms"`
+}
+
+func (d *InfinityWorkerVMStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infinity_worker_vm_statuses"
+}
+
+func (d *InfinityWorkerVMStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(*PexipProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *PexipProvider, got: %T. Please report this issue to the provider developers", req.ProviderData),
+		)
+		return
+	}
+	d.InfinityClient = p.client
+}
+
+func (d *InfinityWorkerVMStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the data source.",
+			},
+			"system_location": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the Conferencing Nodes in the system location with this name.",
+			},
+			"worker_vms": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: workerVMStatusAttributes(),
+				},
+				MarkdownDescription: "The status of each Conferencing Node, in the order returned by the API.",
+			},
+		},
+		MarkdownDescription: "Reads the live status of all Conferencing Nodes from the Infinity status API.",
+	}
+}
+
+func (d *InfinityWorkerVMStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InfinityWorkerVMStatusesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vms, err := listWorkerVMStatuses(ctx, d.InfinityClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Infinity worker VM statuses",
+			fmt.Sprintf("Could not list worker VM statuses: %s", err),
+		)
+		return
+	}
+
+	state.WorkerVMs = []InfinityWorkerVMStatusModel{}
+	for _, vm := range vms {
+		if !state.SystemLocation.IsNull() && vm.SystemLocation != state.SystemLocation.ValueString() {
+			continue
+		}
+		state.WorkerVMs = append(state.WorkerVMs, workerVMStatusModel(vm))
+	}
+	state.ID = types.StringValue("/api/admin/status/v1/worker_vm/")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
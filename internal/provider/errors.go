@@ -0,0 +1,211 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/pexip/go-infinity-sdk/v38"
+)
+
+// ErrorClass categorises an error returned by the Infinity API client, so that
+// resources can decide how to react to it without matching on error strings.
+type ErrorClass int
+
+const (
+	// ErrorClassUnknown is any error that does not fall into another class,
+	// including server errors.
+	ErrorClassUnknown ErrorClass = iota
+	// ErrorClassNotFound means the object does not exist on the Manager.
+	ErrorClassNotFound
+	// ErrorClassConflict means the request conflicts with the current state of
+	// the object.
+	ErrorClassConflict
+	// ErrorClassValidation means the Manager rejected the request body.
+	ErrorClassValidation
+	// ErrorClassAuth means the credentials were rejected or lack permission.
+	ErrorClassAuth
+	// ErrorClassTransport means the request never got a response, e.g. a DNS
+	// failure, a refused connection or a timeout.
+	ErrorClassTransport
+)
+
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorClassNotFound:
+		return "not found"
+	case ErrorClassConflict:
+		return "conflict"
+	case ErrorClassValidation:
+		return "validation"
+	case ErrorClassAuth:
+		return "auth"
+	case ErrorClassTransport:
+		return "transport"
+	default:
+		return "unknown"
+	}
+}
+
+// notFoundError is returned by the read helpers when the Manager answers a
+// lookup with an empty object rather than a 404.
+type notFoundError struct {
+	message string
+}
+
+func (e *notFoundError) Error() string {
+	return e.message
+}
+
+// notFoundErrorf formats a not found error that is classified as
+// ErrorClassNotFound.
+func notFoundErrorf(format string, args ...any) error {
+	return &notFoundError{message: fmt.Sprintf(format, args...)}
+}
+
+// classifyError returns the class of err, based on the HTTP status code of an
+// API error or the type of a transport error.
+func classifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassUnknown
+	}
+
+	var notFound *notFoundError
+	if errors.As(err, &notFound) {
+		return ErrorClassNotFound
+	}
+
+	var apiErr *infinity.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusNotFound, http.StatusGone:
+			return ErrorClassNotFound
+		case http.StatusConflict:
+			return ErrorClassConflict
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			return ErrorClassValidation
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ErrorClassAuth
+		default:
+			return ErrorClassUnknown
+		}
+	}
+
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTransport
+	}
+
+	return ErrorClassUnknown
+}
+
+// isNotFoundError checks if the error means the object does not exist, either
+// because the API returned 404 or because a read helper found no object.
+func isNotFoundError(err error) bool {
+	return classifyError(err) == ErrorClassNotFound
+}
+
+// addAPIErrorDiagnostics adds a diagnostic for an error returned by a create or
+// update request. When the Manager rejects the request with a validation error,
+// the field errors in the response body are added as attribute errors on the
+// matching attributes of plan, so that Terraform points at the offending
+// configuration. Any other error is added as a general error with the given
+// summary and detail.
+func addAPIErrorDiagnostics(ctx context.Context, diags *diag.Diagnostics, plan tfsdk.Plan, err error, summary, detail string) {
+	var apiErr *infinity.APIError
+	var fields map[string]any
+	if plan.Schema == nil || classifyError(err) != ErrorClassValidation || !errors.As(err, &apiErr) ||
+		json.Unmarshal([]byte(apiErr.Details), &fields) != nil {
+		diags.AddError(summary, detail)
+		return
+	}
+
+	isAttribute := func(field string) bool {
+		_, d := plan.Schema.TypeAtPath(ctx, path.Root(field))
+		return !d.HasError()
+	}
+
+	// Field errors are usually keyed by the API resource name, e.g.
+	// {"conference_alias": {"alias": ["Conference alias with this Alias already exists."]}}
+	if len(fields) == 1 {
+		for key, value := range fields {
+			if nested, ok := value.(map[string]any); ok && !isAttribute(key) {
+				fields = nested
+			}
+		}
+	}
+
+	var attributeDiags, otherDiags diag.Diagnostics
+	for _, field := range sortedKeys(fields) {
+		if !isAttribute(field) {
+			for _, message := range fieldErrorMessages(field, fields[field]) {
+				otherDiags.AddError(summary, message)
+			}
+			continue
+		}
+		for _, message := range fieldErrorMessages("", fields[field]) {
+			attributeDiags.AddAttributeError(path.Root(field), summary, message)
+		}
+	}
+
+	// Without any attribute to point at, the full error is more useful
+	if len(attributeDiags) == 0 {
+		diags.AddError(summary, detail)
+		return
+	}
+	diags.Append(attributeDiags...)
+	diags.Append(otherDiags...)
+}
+
+// fieldErrorMessages flattens the errors reported for a field, prefixing each
+// message with the field name if there is one.
+func fieldErrorMessages(field string, value any) []string {
+	var messages []string
+	switch v := value.(type) {
+	case []any:
+		for _, element := range v {
+			messages = append(messages, fieldErrorMessages(field, element)...)
+		}
+	case map[string]any:
+		for _, key := range sortedKeys(v) {
+			nested := key
+			if field != "" {
+				nested = field + "." + key
+			}
+			messages = append(messages, fieldErrorMessages(nested, v[key])...)
+		}
+	case nil:
+	default:
+		if field == "" {
+			messages = append(messages, fmt.Sprint(v))
+		} else {
+			messages = append(messages, fmt.Sprintf("%s: %v", field, v))
+		}
+	}
+	return messages
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,148 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/pexip/go-infinity-sdk/v38"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyError(t *testing.T) {
+	dnsErr := &url.Error{
+		Op:  "Get",
+		URL: "https://manager.example.com/api/admin/configuration/v1/dns_server/1/",
+		Err: &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "manager.example.com", IsNotFound: true}},
+	}
+
+	tests := []struct {
+		name  string
+		err   error
+		class ErrorClass
+	}{
+		{"nil", nil, ErrorClassUnknown},
+		{"plain error", errors.New("something went wrong"), ErrorClassUnknown},
+		{"plain error mentioning 404", errors.New("conference alias 404 is reserved"), ErrorClassUnknown},
+		{"API 404", &infinity.APIError{StatusCode: http.StatusNotFound, Message: "Not Found"}, ErrorClassNotFound},
+		{"API 410", &infinity.APIError{StatusCode: http.StatusGone}, ErrorClassNotFound},
+		{"wrapped API 404", fmt.Errorf("get failed: %w", &infinity.APIError{StatusCode: http.StatusNotFound}), ErrorClassNotFound},
+		{"read helper not found", notFoundErrorf("DNS server with ID %d not found", 1), ErrorClassNotFound},
+		{"API 409", &infinity.APIError{StatusCode: http.StatusConflict}, ErrorClassConflict},
+		{"API 400", &infinity.APIError{StatusCode: http.StatusBadRequest, Details: `{"dns_server": {"address": ["Enter a valid IPv4 or IPv6 address."]}}`}, ErrorClassValidation},
+		{"API 422", &infinity.APIError{StatusCode: http.StatusUnprocessableEntity}, ErrorClassValidation},
+		{"API 401", &infinity.APIError{StatusCode: http.StatusUnauthorized}, ErrorClassAuth},
+		{"API 403", &infinity.APIError{StatusCode: http.StatusForbidden}, ErrorClassAuth},
+		{"API 500", &infinity.APIError{StatusCode: http.StatusInternalServerError, Message: "lookup failed"}, ErrorClassUnknown},
+		{"DNS lookup failure", dnsErr, ErrorClassTransport},
+		{"wrapped DNS lookup failure", fmt.Errorf("failed to perform HTTP request after 1 attempts: %w", dnsErr), ErrorClassTransport},
+		{"bare DNS error", &net.DNSError{Err: "server misbehaving", Name: "manager.example.com"}, ErrorClassTransport},
+		{"timeout", fmt.Errorf("request failed: %w", context.DeadlineExceeded), ErrorClassTransport},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.class, classifyError(tt.err))
+			require.Equal(t, tt.class == ErrorClassNotFound, isNotFoundError(tt.err))
+		})
+	}
+}
+
+func TestClassifyError_TransportFailure(t *testing.T) {
+	// A request to an unresolvable host must never be mistaken for a 404
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://manager.invalid/api/admin/configuration/v1/dns_server/1/", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	require.Error(t, err)
+	require.Equal(t, ErrorClassTransport, classifyError(err))
+	require.False(t, isNotFoundError(err))
+}
+
+func TestErrorClass_String(t *testing.T) {
+	require.Equal(t, "not found", ErrorClassNotFound.String())
+	require.Equal(t, "conflict", ErrorClassConflict.String())
+	require.Equal(t, "validation", ErrorClassValidation.String())
+	require.Equal(t, "auth", ErrorClassAuth.String())
+	require.Equal(t, "transport", ErrorClassTransport.String())
+	require.Equal(t, "unknown", ErrorClassUnknown.String())
+}
+
+func TestAddAPIErrorDiagnostics(t *testing.T) {
+	schemaResp := &resource.SchemaResponse{}
+	(&InfinityDnsServerResource{}).Schema(t.Context(), resource.SchemaRequest{}, schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+
+	validationErr := func(body string) error {
+		return fmt.Errorf("create failed: %w", &infinity.APIError{StatusCode: http.StatusBadRequest, Message: "Bad Request", Details: body})
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected diag.Diagnostics
+	}{
+		{
+			name: "field errors keyed by resource name",
+			err:  validationErr(`{"dns_server": {"address": ["Enter a valid IPv4 or IPv6 address."], "description": ["Ensure this field has no more than 250 characters.", "Invalid characters."]}}`),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("address"), "Error Creating", "Enter a valid IPv4 or IPv6 address."),
+				diag.NewAttributeErrorDiagnostic(path.Root("description"), "Error Creating", "Ensure this field has no more than 250 characters."),
+				diag.NewAttributeErrorDiagnostic(path.Root("description"), "Error Creating", "Invalid characters."),
+			},
+		},
+		{
+			name: "top level field errors with an unknown field",
+			err:  validationErr(`{"address": ["DNS server with this Address already exists."], "__all__": ["Something else is wrong."]}`),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("address"), "Error Creating", "DNS server with this Address already exists."),
+				diag.NewErrorDiagnostic("Error Creating", "__all__: Something else is wrong."),
+			},
+		},
+		{
+			name: "no matching attribute",
+			err:  validationErr(`{"dns_server": {"__all__": ["Something is wrong."]}}`),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Creating", "Could not create"),
+			},
+		},
+		{
+			name: "body is not JSON",
+			err:  validationErr(`Bad Request`),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Creating", "Could not create"),
+			},
+		},
+		{
+			name: "not a validation error",
+			err:  &infinity.APIError{StatusCode: http.StatusInternalServerError, Details: `{"address": ["Server error."]}`},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Creating", "Could not create"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addAPIErrorDiagnostics(t.Context(), &diags, plan, tt.err, "Error Creating", "Could not create")
+			require.Equal(t, tt.expected, diags)
+		})
+	}
+}
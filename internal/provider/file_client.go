@@ -0,0 +1,147 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	sdkauth "github.com/pexip/go-infinity-sdk/v38/auth"
+
+	"github.com/pexip/terraform-provider-pexip/internal/transport"
+)
+
+// fileErrorBodyLength is the number of bytes of an error response that are
+// included in the error.
+const fileErrorBodyLength = 1024
+
+// fileClient transfers files such as backups to and from the Manager. The SDK
+// client reads every response into memory, which does not suit archives of
+// hundreds of megabytes, so files are streamed with the provider's HTTP client
+// instead. Transfers are not subject to request_timeout.
+type fileClient struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	auth       sdkauth.Authenticator
+	userAgent  string
+}
+
+func newFileClient(address string, httpClient *http.Client, authenticator sdkauth.Authenticator, userAgent string) (*fileClient, error) {
+	baseURL, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+	return &fileClient{
+		baseURL:    baseURL,
+		httpClient: httpClient,
+		auth:       authenticator,
+		userAgent:  userAgent,
+	}, nil
+}
+
+// url returns the URL of an API endpoint, e.g. `status/v1/backup_request/`, or
+// of a path such as a download URI returned by the API.
+func (c *fileClient) url(endpoint string) string {
+	if strings.HasPrefix(endpoint, "/") {
+		return c.baseURL.JoinPath(endpoint).String()
+	}
+	return c.baseURL.JoinPath("/api/admin/", endpoint).String()
+}
+
+func (c *fileClient) do(ctx context.Context, method, endpoint, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(transport.WithoutRequestTimeout(ctx), method, c.url(endpoint), body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if c.auth != nil {
+		if err := c.auth.Authenticate(req); err != nil {
+			return nil, fmt.Errorf("failed to authenticate request: %w", err)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		details, _ := io.ReadAll(io.LimitReader(resp.Body, fileErrorBodyLength))
+		return nil, fmt.Errorf("%s %s failed with status %d: %s", method, req.URL.Path, resp.StatusCode, strings.TrimSpace(string(details)))
+	}
+	return resp, nil
+}
+
+// download streams the file at endpoint to w. It returns the number of bytes
+// written and the length the Manager announced, which is -1 if unknown.
+func (c *fileClient) download(ctx context.Context, endpoint string, w io.Writer) (written, length int64, err error) {
+	resp, err := c.do(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	written, err = io.Copy(w, resp.Body)
+	return written, resp.ContentLength, err
+}
+
+// upload streams r to endpoint as the file field of a multipart form, after
+// the other form fields. The JSON response is decoded into result unless it is
+// nil or the response is empty. Uploads are not retried, as the body cannot be
+// replayed.
+func (c *fileClient) upload(ctx context.Context, endpoint string, fields map[string]string, fileField, filename string, r io.Reader, result any) error {
+	body, w := io.Pipe()
+	form := multipart.NewWriter(w)
+	go func() {
+		_ = w.CloseWithError(writeMultipartForm(form, fields, fileField, filename, r))
+	}()
+
+	resp, err := c.do(ctx, http.MethodPost, endpoint, form.FormDataContentType(), body)
+	if err != nil {
+		_ = body.CloseWithError(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func writeMultipartForm(form *multipart.Writer, fields map[string]string, fileField, filename string, r io.Reader) error {
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		if err := form.WriteField(key, fields[key]); err != nil {
+			return fmt.Errorf("failed to write form field %s: %w", key, err)
+		}
+	}
+	part, err := form.CreateFormFile(fileField, filename)
+	if err != nil {
+		return fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return err
+	}
+	return form.Close()
+}
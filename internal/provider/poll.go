@@ -0,0 +1,50 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// errPollTimeout is returned by pollUntil when the condition is not met
+// within the timeout.
+var errPollTimeout = errors.New("timed out")
+
+// pollUntil calls check every interval until it reports that it is done or
+// returns an error, or until timeout has passed. The first check is made
+// immediately.
+func pollUntil(ctx context.Context, timeout, interval time.Duration, check func(ctx context.Context) (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("%w after %s", errPollTimeout, timeout)
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("%w after %s", errPollTimeout, timeout)
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -8,18 +8,22 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -27,13 +31,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/pexip/go-infinity-sdk/v38"
+	sdkauth "github.com/pexip/go-infinity-sdk/v38/auth"
 	"github.com/pexip/go-infinity-sdk/v38/command"
 	"github.com/pexip/go-infinity-sdk/v38/config"
 	"github.com/pexip/go-infinity-sdk/v38/history"
 	"github.com/pexip/go-infinity-sdk/v38/interfaces"
 	"github.com/pexip/go-infinity-sdk/v38/status"
 
+	"github.com/pexip/terraform-provider-pexip/internal/auth"
 	"github.com/pexip/terraform-provider-pexip/internal/provider/validators"
+	"github.com/pexip/terraform-provider-pexip/internal/transport"
 	"github.com/pexip/terraform-provider-pexip/internal/version"
 )
 
@@ -43,16 +50,43 @@ var (
 )
 
 type PexipProviderModel struct {
-	Address  types.String `tfsdk:"address"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Insecure types.Bool   `tfsdk:"insecure"`
+	Address               types.String `tfsdk:"address"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	PasswordFile          types.String `tfsdk:"password_file"`
+	CredentialCommand     types.List   `tfsdk:"credential_command"`
+	Insecure              types.Bool   `tfsdk:"insecure"`
+	OAuth2ClientID        types.String `tfsdk:"oauth2_client_id"`
+	OAuth2PrivateKey      types.String `tfsdk:"oauth2_private_key"`
+	OAuth2PrivateKeyFile  types.String `tfsdk:"oauth2_private_key_file"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin          types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax          types.String `tfsdk:"retry_wait_max"`
+	RetryStatusCodes      types.List   `tfsdk:"retry_status_codes"`
+	RequestTimeout        types.String `tfsdk:"request_timeout"`
+	CACertificate         types.String `tfsdk:"ca_certificate"`
+	CAFile                types.String `tfsdk:"ca_file"`
+	ClientCertificate     types.String `tfsdk:"client_certificate"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	LogMaxBodyLength      types.Int64  `tfsdk:"log_max_body_length"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	MaxRequestsPerSecond  types.Int64  `tfsdk:"max_requests_per_second"`
+	SerializeWrites       types.List   `tfsdk:"serialize_writes"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+	WaitForReady          types.Bool   `tfsdk:"wait_for_ready"`
+	WaitForReadyTimeout   types.String `tfsdk:"wait_for_ready_timeout"`
 }
 
 type PexipProvider struct {
 	Address string
 	Mutex   *sync.Mutex
 	client  InfinityClient
+	files   *fileClient
+
+	// Credentials printed by credential_command, cached for the lifetime of
+	// the provider
+	credentials    *commandCredentials
+	credentialsKey string
 }
 
 type InfinityClient interface {
@@ -107,10 +141,143 @@ func (p *PexipProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				},
 				MarkdownDescription: "Pexip Infinity Manager password for authentication. Can also be set via the `PEXIP_PASSWORD` environment variable.",
 			},
+			"password_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("credential_command")),
+				},
+				MarkdownDescription: "Path to a file containing the password, e.g. a secret mounted by the CI system. A trailing newline is ignored. Can also be set via the `PEXIP_PASSWORD_FILE` environment variable.",
+			},
+			"credential_command": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("password")),
+				},
+				MarkdownDescription: "Command and arguments of a local executable that prints the credentials as a JSON object, `{\"username\": \"...\", \"password\": \"...\"}`, on its standard output. The username is optional and overrides `username`. The command runs once, when the provider is configured, and its output is cached for the rest of the run. Can also be set via the `PEXIP_CREDENTIAL_COMMAND` environment variable, as words separated by spaces.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Trust self-signed or otherwise invalid certificates. Defaults to `false`. Can also be set via the `PEXIP_INSECURE` environment variable.",
 			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system roots, e.g. for a Manager with a certificate issued by an internal CA. Can also be set via the `PEXIP_CA_CERTIFICATE` environment variable.",
+			},
+			"ca_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a file containing PEM encoded CA certificates to trust in addition to the system roots. Can also be set via the `PEXIP_CA_FILE` environment variable.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM encoded client certificate presented to the Manager for mutual TLS. When the Manager uses `client_certificate` authentication (see `pexip_infinity_authentication`), `username` and `password` may be omitted. Can also be set via the `PEXIP_CLIENT_CERTIFICATE` environment variable.",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM encoded private key for `client_certificate`. Can also be set via the `PEXIP_CLIENT_KEY` environment variable.",
+			},
+			"oauth2_client_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("username"), path.MatchRoot("password"), path.MatchRoot("password_file"), path.MatchRoot("credential_command")),
+				},
+				MarkdownDescription: "Client ID of an Infinity OAuth2 client (see `pexip_infinity_oauth2_client`) to authenticate with instead of `username` and `password`. Requires `oauth2_private_key` or `oauth2_private_key_file`. Can also be set via the `PEXIP_OAUTH2_CLIENT_ID` environment variable, which is ignored when the provider config sets `username`, `password`, `password_file` or `credential_command`.",
+			},
+			"oauth2_private_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("oauth2_private_key_file")),
+				},
+				MarkdownDescription: "PEM encoded private key of the OAuth2 client, used to sign the `private_key_jwt` client assertion. Can also be set via the `PEXIP_OAUTH2_PRIVATE_KEY` environment variable.",
+			},
+			"oauth2_private_key_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a file containing the PEM encoded private key of the OAuth2 client. Can also be set via the `PEXIP_OAUTH2_PRIVATE_KEY_FILE` environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Maximum number of times a failed API request is retried. Defaults to `2`. Can also be set via the `PEXIP_MAX_RETRIES` environment variable.",
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "Time to wait before the first retry, e.g. `1s`. The wait doubles on every further retry, with random jitter, up to `retry_wait_max`. A `Retry-After` header on the response takes precedence. Defaults to `1s`. Can also be set via the `PEXIP_RETRY_WAIT_MIN` environment variable.",
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "Maximum time to wait between retries, e.g. `30s`. Defaults to `30s`. Can also be set via the `PEXIP_RETRY_WAIT_MAX` environment variable.",
+			},
+			"retry_status_codes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+				MarkdownDescription: "HTTP status codes that cause a request to be retried. Network errors are retried for idempotent requests regardless. Defaults to `[429, 500, 502, 503, 504]`. Can also be set via the `PEXIP_RETRY_STATUS_CODES` environment variable as a comma separated list.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "Timeout for each individual API request attempt, e.g. `30s`. Defaults to `30s`. Can also be set via the `PEXIP_REQUEST_TIMEOUT` environment variable.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Maximum number of API requests in flight at once, across all resources. Use this to protect the Manager when applying many resources with Terraform's default parallelism. Defaults to `0`, which is unlimited. Can also be set via the `PEXIP_MAX_CONCURRENT_REQUESTS` environment variable.",
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Maximum sustained rate of API requests per second, with bursts of up to one second's worth of requests. Defaults to `0`, which is unlimited. Can also be set via the `PEXIP_MAX_REQUESTS_PER_SECOND` environment variable.",
+			},
+			"serialize_writes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^pexip_infinity_[a-z0-9_]+$`), "must be a resource type, e.g. pexip_infinity_worker_vm")),
+				},
+				MarkdownDescription: "Resource types whose create, update and delete requests are sent one at a time, for API endpoints that deadlock under concurrent writes, e.g. `[\"pexip_infinity_system_location\", \"pexip_infinity_worker_vm\"]`. Reads are not affected. Names that are not resource types of this provider are rejected. Can also be set via the `PEXIP_SERIALIZE_WRITES` environment variable as a comma separated list.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Refuse to change anything on the Manager. Every create, update and delete, and every action that changes the Manager, fails before a request is sent, while refresh, import and data sources keep working, e.g. for `terraform plan` in CI with a read-only admin account. Defaults to `false`. Can also be set via the `PEXIP_READ_ONLY` environment variable.",
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Wait for the Manager to become ready before the first API request, instead of failing. Use this when the Management node is built earlier in the same apply. The provider polls until the Manager answers authenticated requests and has finished its initial configuration. Defaults to `false`. Can also be set via the `PEXIP_WAIT_FOR_READY` environment variable.",
+			},
+			"wait_for_ready_timeout": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "How long `wait_for_ready` waits for the Manager, e.g. `15m`. Defaults to `10m`. Can also be set via the `PEXIP_WAIT_FOR_READY_TIMEOUT` environment variable.",
+			},
+			"log_max_body_length": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+				MarkdownDescription: "Maximum number of bytes of each request and response body to log when `TF_LOG` is `DEBUG` or `TRACE`. Set to `-1` to log bodies in full. Defaults to `1000`. Can also be set via the `PEXIP_LOG_MAX_BODY_LENGTH` environment variable.",
+			},
 		},
 	}
 }
@@ -124,55 +291,68 @@ func (p *PexipProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	// If any values are unknown (e.g. referencing resources not yet created),
 	// return early so Terraform can defer provider configuration until apply.
-	if data.Address.IsUnknown() || data.Username.IsUnknown() || data.Password.IsUnknown() || data.Insecure.IsUnknown() {
+	if !req.Config.Raw.IsFullyKnown() {
 		return
 	}
 
-	address := data.Address.ValueString()
-	addressFromEnv := false
-	if data.Address.IsNull() {
-		address = os.Getenv("PEXIP_ADDRESS")
-		addressFromEnv = true
+	address, addressFromEnv := stringValueOrEnv(data.Address, "PEXIP_ADDRESS")
+	username, usernameFromEnv := stringValueOrEnv(data.Username, "PEXIP_USERNAME")
+	password, passwordFromEnv := stringValueOrEnv(data.Password, "PEXIP_PASSWORD")
+	oauth2ClientID := data.oauth2ClientID()
+	if oauth2ClientID == "" {
+		usernameReplaced, passwordReplaced := p.resolvePassword(ctx, &data, &username, &password, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		usernameFromEnv = usernameFromEnv && !usernameReplaced
+		passwordFromEnv = passwordFromEnv && !passwordReplaced
 	}
+	oauth2PrivateKey, _ := stringValueOrEnv(data.OAuth2PrivateKey, "PEXIP_OAUTH2_PRIVATE_KEY")
+	oauth2PrivateKeyFile, _ := stringValueOrEnv(data.OAuth2PrivateKeyFile, "PEXIP_OAUTH2_PRIVATE_KEY_FILE")
 
-	username := data.Username.ValueString()
-	usernameFromEnv := false
-	if data.Username.IsNull() {
-		username = os.Getenv("PEXIP_USERNAME")
-		usernameFromEnv = true
+	insecure := boolValueOrEnv(data.Insecure, "PEXIP_INSECURE", "insecure", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	password := data.Password.ValueString()
-	passwordFromEnv := false
-	if data.Password.IsNull() {
-		password = os.Getenv("PEXIP_PASSWORD")
-		passwordFromEnv = true
+	tlsConfig, diags := data.tlsConfig(insecure)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	insecure := data.Insecure.ValueBool()
-	if data.Insecure.IsNull() {
-		if val := os.Getenv("PEXIP_INSECURE"); val != "" {
-			var err error
-			insecure, err = strconv.ParseBool(val)
-			if err != nil {
-				resp.Diagnostics.AddAttributeError(path.Root("insecure"), "Invalid PEXIP_INSECURE value",
-					fmt.Sprintf("Cannot parse PEXIP_INSECURE=%q as a boolean: %s", val, err))
-				return
-			}
-		}
-	}
+	// OAuth2 client credentials take the place of username and password. With a
+	// client certificate, the Manager may authenticate the certificate instead,
+	// so username and password are only required if either of them is set.
+	useOAuth2 := oauth2ClientID != ""
+	useClientCertificate := len(tlsConfig.Certificates) > 0
+	useBasicAuth := !useOAuth2 && (!useClientCertificate || username != "" || password != "")
 
 	if address == "" {
 		resp.Diagnostics.AddAttributeError(path.Root("address"), "Missing address",
 			"Expected address to be set in provider config or via the PEXIP_ADDRESS environment variable.")
 	}
-	if username == "" {
-		resp.Diagnostics.AddAttributeError(path.Root("username"), "Missing username",
-			"Expected username to be set in provider config or via the PEXIP_USERNAME environment variable.")
+	if useOAuth2 {
+		if oauth2PrivateKey == "" && oauth2PrivateKeyFile == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("oauth2_private_key"), "Missing OAuth2 private key",
+				"Expected oauth2_private_key or oauth2_private_key_file to be set in provider config or via the PEXIP_OAUTH2_PRIVATE_KEY "+
+					"or PEXIP_OAUTH2_PRIVATE_KEY_FILE environment variables when oauth2_client_id is set.")
+		}
+		if oauth2PrivateKey != "" && oauth2PrivateKeyFile != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("oauth2_private_key"), "Conflicting OAuth2 private key",
+				"Only one of oauth2_private_key and oauth2_private_key_file may be set.")
+		}
 	}
-	if password == "" {
-		resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing password",
-			"Expected password to be set in provider config or via the PEXIP_PASSWORD environment variable.")
+	if useBasicAuth {
+		if username == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("username"), "Missing username",
+				"Expected username to be set in provider config or via the PEXIP_USERNAME environment variable.")
+		}
+		if password == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing password",
+				"Expected password, password_file or credential_command to be set in provider config or via the PEXIP_PASSWORD, "+
+					"PEXIP_PASSWORD_FILE or PEXIP_CREDENTIAL_COMMAND environment variables.")
+		}
 	}
 	if resp.Diagnostics.HasError() {
 		return
@@ -190,11 +370,11 @@ func (p *PexipProvider) Configure(ctx context.Context, req provider.ConfigureReq
 				fmt.Sprintf("PEXIP_ADDRESS=%q must be an HTTPS URL, but scheme is %q", address, u.Scheme))
 		}
 	}
-	if usernameFromEnv && len(username) < 4 {
+	if useBasicAuth && usernameFromEnv && len(username) < 4 {
 		resp.Diagnostics.AddAttributeError(path.Root("username"), "Invalid PEXIP_USERNAME value",
 			fmt.Sprintf("PEXIP_USERNAME must be at least 4 characters, got %d", len(username)))
 	}
-	if passwordFromEnv && len(password) < 4 {
+	if useBasicAuth && passwordFromEnv && len(password) < 4 {
 		resp.Diagnostics.AddAttributeError(path.Root("password"), "Invalid PEXIP_PASSWORD value",
 			fmt.Sprintf("PEXIP_PASSWORD must be at least 4 characters, got %d", len(password)))
 	}
@@ -202,25 +382,83 @@ func (p *PexipProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	retryPolicy, diags := data.retryPolicy(ctx)
+	resp.Diagnostics.Append(diags...)
+	limitPolicy, diags := data.limitPolicy(ctx, p.resourceTypes(ctx))
+	resp.Diagnostics.Append(diags...)
+	logMaxBodyLength, _ := int64ValueOrEnv(data.LogMaxBodyLength, "PEXIP_LOG_MAX_BODY_LENGTH", "log_max_body_length", &resp.Diagnostics)
+	if logMaxBodyLength < -1 {
+		resp.Diagnostics.AddAttributeError(path.Root("log_max_body_length"), "Invalid PEXIP_LOG_MAX_BODY_LENGTH value",
+			fmt.Sprintf("PEXIP_LOG_MAX_BODY_LENGTH must be at least -1, got %d", logMaxBodyLength))
+	}
+	readOnly := boolValueOrEnv(data.ReadOnly, "PEXIP_READ_ONLY", "read_only", &resp.Diagnostics)
+	waitForReady := boolValueOrEnv(data.WaitForReady, "PEXIP_WAIT_FOR_READY", "wait_for_ready", &resp.Diagnostics)
+	waitForReadyTimeout, ok := durationValueOrEnv(data.WaitForReadyTimeout, "PEXIP_WAIT_FOR_READY_TIMEOUT", "wait_for_ready_timeout", &resp.Diagnostics)
+	if !ok {
+		waitForReadyTimeout = defaultWaitForReadyTimeout
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if p.client == nil {
 		var err error
 		userAgent := fmt.Sprintf("terraform-provider-pexip/%s", version.Version().String())
 
-		p.client, err = infinity.New(
-			infinity.WithBaseURL(address),
-			infinity.WithBasicAuth(username, password),
-			infinity.WithUserAgent(userAgent),
-			infinity.WithMaxRetries(2),
-			infinity.WithTransport(&http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: insecure, // #nosec G402 -- This is intentionally configurable for testing environments
-					MinVersion:         tls.VersionTLS12,
-				},
+		// Retries and per-request timeouts are handled by the transport rather than
+		// the SDK, so that Retry-After and the configured status codes are honoured.
+		retryTransport := &transport.RetryTransport{
+			Base: transport.NewLimitTransport(p.loggingTransport(ctx, &http.Transport{
+				TLSClientConfig:     tlsConfig,
 				MaxIdleConns:        30,
 				MaxIdleConnsPerHost: 5,
 				IdleConnTimeout:     60 * time.Second,
-			}),
-		)
+			}, int(logMaxBodyLength)), limitPolicy),
+			Policy: retryPolicy,
+		}
+		httpClient := &http.Client{Transport: retryTransport}
+		if readOnly {
+			httpClient.Transport = &transport.ReadOnlyTransport{Base: retryTransport}
+		}
+
+		options := []infinity.ClientOption{
+			infinity.WithBaseURL(address),
+			infinity.WithUserAgent(userAgent),
+			infinity.WithNoRetries(),
+			infinity.WithHTTPClient(httpClient),
+		}
+		var authenticator sdkauth.Authenticator
+		if useBasicAuth {
+			authenticator = sdkauth.NewBasicAuth(username, password)
+		}
+		if useOAuth2 {
+			keyPath := path.Root("oauth2_private_key")
+			keyPEM := []byte(oauth2PrivateKey)
+			if oauth2PrivateKeyFile != "" {
+				keyPath = path.Root("oauth2_private_key_file")
+				keyPEM, err = os.ReadFile(oauth2PrivateKeyFile) // #nosec G304 -- Path is provided by the operator
+				if err != nil {
+					resp.Diagnostics.AddAttributeError(keyPath, "Failed to read OAuth2 private key",
+						fmt.Sprintf("Could not read OAuth2 private key file %q: %s", oauth2PrivateKeyFile, err))
+					return
+				}
+			}
+
+			// Token requests share the TLS and retry settings of API requests. They
+			// are POSTs, but do not change anything, so read_only does not apply.
+			tokenURL := strings.TrimSuffix(address, "/") + auth.TokenPath
+			authenticator, err = auth.NewOAuth2ClientCredentials(oauth2ClientID, tokenURL, keyPEM, &http.Client{Transport: retryTransport})
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(keyPath, "Invalid OAuth2 client configuration",
+					fmt.Sprintf("Could not configure OAuth2 client credentials authentication: %s", err))
+				return
+			}
+		}
+		if authenticator != nil {
+			options = append(options, infinity.WithAuth(authenticator))
+		}
+
+		p.client, err = infinity.New(options...)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to create Infinity SDK client",
@@ -228,6 +466,21 @@ func (p *PexipProvider) Configure(ctx context.Context, req provider.ConfigureReq
 			)
 			return
 		}
+		if waitForReady {
+			p.client = newReadyClient(p.client, waitForReadyTimeout)
+		}
+
+		p.files, err = newFileClient(address, httpClient, authenticator, userAgent)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to create Infinity file client",
+				fmt.Sprintf("Could not create client for file transfers: %s", err),
+			)
+			return
+		}
+	}
+	if _, ok := p.client.(*readOnlyClient); readOnly && !ok {
+		p.client = newReadOnlyClient(p.client)
 	}
 
 	// Pass the configured provider to resources, data sources, and actions.
@@ -330,11 +583,124 @@ func (p *PexipProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		func() datasource.DataSource {
 			return &InfinityPermissionDataSource{}
 		},
+		func() datasource.DataSource {
+			return &InfinityWorkerVMStatusDataSource{}
+		},
+		func() datasource.DataSource {
+			return &InfinityWorkerVMStatusesDataSource{}
+		},
+		func() datasource.DataSource {
+			return &InfinityAlarmsDataSource{}
+		},
+		func() datasource.DataSource {
+			return &InfinityLicenceUsageDataSource{}
+		},
+		func() datasource.DataSource {
+			return &InfinityConferenceHistoryDataSource{}
+		},
+		func() datasource.DataSource {
+			return &InfinityParticipantHistoryDataSource{}
+		},
+		func() datasource.DataSource {
+			return &InfinityConferenceStatusesDataSource{}
+		},
+		func() datasource.DataSource {
+			return &InfinityParticipantStatusesDataSource{}
+		},
 	}
 }
 
 func (p *PexipProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		func() action.Action { return &InfinityDeleteDefaultMgrTLSCertificateAction{} },
+		func() action.Action { return &InfinityHealthCheckAction{} },
+		func() action.Action { return &InfinityLDAPSyncAction{} },
+		func() action.Action { return &InfinityBackupAction{} },
+		func() action.Action { return &InfinityBackupRestoreAction{} },
+		func() action.Action { return &InfinitySnapshotAction{} },
+		func() action.Action { return &InfinitySendProvisioningEmailAction{} },
+	}
+}
+
+// oauth2ClientID returns the configured OAuth2 client ID. PEXIP_OAUTH2_CLIENT_ID
+// is only used when the provider config sets neither the client ID nor a
+// username or password, so the environment cannot override credentials set in
+// the config. The schema rejects configs that set both.
+func (m PexipProviderModel) oauth2ClientID() string {
+	if !m.OAuth2ClientID.IsNull() {
+		return m.OAuth2ClientID.ValueString()
+	}
+	if !m.Username.IsNull() || !m.Password.IsNull() || !m.PasswordFile.IsNull() || !m.CredentialCommand.IsNull() {
+		return ""
+	}
+	return os.Getenv("PEXIP_OAUTH2_CLIENT_ID")
+}
+
+// stringValueOrEnv returns the configured value of a string attribute, falling
+// back to the named environment variable when the attribute is not set. The
+// second return value reports whether the environment variable was used.
+func stringValueOrEnv(value types.String, key string) (string, bool) {
+	if value.IsNull() {
+		return os.Getenv(key), true
+	}
+	return value.ValueString(), false
+}
+
+// int64ValueOrEnv returns the configured value of an integer attribute, or the
+// parsed value of the named environment variable when the attribute is not set.
+// It returns false if neither is set or the environment variable is invalid.
+func int64ValueOrEnv(value types.Int64, key, attribute string, diags *diag.Diagnostics) (int64, bool) {
+	if !value.IsNull() {
+		return value.ValueInt64(), true
+	}
+	val := os.Getenv(key)
+	if val == "" {
+		return 0, false
+	}
+	i, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), fmt.Sprintf("Invalid %s value", key),
+			fmt.Sprintf("Cannot parse %s=%q as an integer: %s", key, val, err))
+		return 0, false
+	}
+	return i, true
+}
+
+// boolValueOrEnv returns the configured value of a bool attribute, or the
+// parsed value of the named environment variable when the attribute is not set.
+func boolValueOrEnv(value types.Bool, key, attribute string, diags *diag.Diagnostics) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+	val := os.Getenv(key)
+	if val == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), fmt.Sprintf("Invalid %s value", key),
+			fmt.Sprintf("Cannot parse %s=%q as a boolean: %s", key, val, err))
+		return false
+	}
+	return b
+}
+
+// durationValueOrEnv returns the configured value of a duration attribute, or
+// the parsed value of the named environment variable when the attribute is not
+// set. It returns false if neither is set or the value is invalid.
+func durationValueOrEnv(value types.String, key, attribute string, diags *diag.Diagnostics) (time.Duration, bool) {
+	val, fromEnv := stringValueOrEnv(value, key)
+	if val == "" {
+		return 0, false
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		summary, detail := "Invalid Duration", fmt.Sprintf("The value %q is not a positive duration, e.g. 30s or 5m.", val)
+		if fromEnv {
+			summary, detail = fmt.Sprintf("Invalid %s value", key), fmt.Sprintf("%s=%q must be a positive duration, e.g. 30s or 5m.", key, val)
+		}
+		diags.AddAttributeError(path.Root(attribute), summary, detail)
+		return 0, false
 	}
+	return d, true
 }
@@ -0,0 +1,167 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const credentialCommandTimeout = time.Minute
+
+// commandCredentials is the JSON document a credential_command prints on its
+// standard output.
+type commandCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// credentialCommand returns the configured credential command and its
+// arguments, or the whitespace separated words of PEXIP_CREDENTIAL_COMMAND.
+func (m *PexipProviderModel) credentialCommand(ctx context.Context) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var command []string
+	if !m.CredentialCommand.IsNull() {
+		diags.Append(m.CredentialCommand.ElementsAs(ctx, &command, false)...)
+	} else {
+		command = strings.Fields(os.Getenv("PEXIP_CREDENTIAL_COMMAND"))
+	}
+	return command, diags
+}
+
+// readPasswordFile returns the contents of a password file without the
+// trailing newline that editors and `echo` add.
+func readPasswordFile(name string) (string, error) {
+	data, err := os.ReadFile(name) // #nosec G304 -- Path is provided by the operator
+	if err != nil {
+		return "", err
+	}
+	password := strings.TrimRight(string(data), "\r\n")
+	if password == "" {
+		return "", errors.New("the file is empty")
+	}
+	return password, nil
+}
+
+// commandCredentials runs the credential command and returns the credentials
+// it prints. The result is cached for the lifetime of the provider, so the
+// command runs once even if the provider is configured again with the same
+// command.
+func (p *PexipProvider) commandCredentials(ctx context.Context, command []string) (*commandCredentials, error) {
+	p.Mutex.Lock()
+	defer p.Mutex.Unlock()
+
+	key := strings.Join(command, "\x00")
+	if p.credentials != nil && p.credentialsKey == key {
+		return p.credentials, nil
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Running credential command %q", command[0]))
+	ctx, cancel := context.WithTimeout(ctx, credentialCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...) // #nosec G204 -- Command is provided by the operator
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("%q did not finish within %s", command[0], credentialCommandTimeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%q failed: %w: %s", command[0], err, msg)
+		}
+		return nil, fmt.Errorf("%q failed: %w", command[0], err)
+	}
+
+	// The output holds the password, so it is never included in errors
+	credentials := &commandCredentials{}
+	if err := json.Unmarshal(stdout.Bytes(), credentials); err != nil {
+		return nil, fmt.Errorf("%q did not print a JSON object with username and password", command[0])
+	}
+	if credentials.Password == "" {
+		return nil, fmt.Errorf("%q did not print a password", command[0])
+	}
+
+	p.credentials = credentials
+	p.credentialsKey = key
+	return credentials, nil
+}
+
+// resolvePassword sets password, and username if the credential command
+// provides one, from password_file or credential_command. At most one of
+// password, password_file and credential_command may be set; one set in the
+// provider config takes precedence over the environment variables of the
+// others. It reports whether username and password were replaced, so that they
+// are not validated as environment variables.
+func (p *PexipProvider) resolvePassword(ctx context.Context, data *PexipProviderModel, username, password *string, diags *diag.Diagnostics) (usernameReplaced, passwordReplaced bool) {
+	passwordFile, _ := stringValueOrEnv(data.PasswordFile, "PEXIP_PASSWORD_FILE")
+	command, commandDiags := data.credentialCommand(ctx)
+	diags.Append(commandDiags...)
+	if diags.HasError() {
+		return false, false
+	}
+
+	switch {
+	case !data.Password.IsNull():
+		passwordFile, command = "", nil
+	case !data.PasswordFile.IsNull():
+		*password, command = "", nil
+	case !data.CredentialCommand.IsNull():
+		*password, passwordFile = "", ""
+	}
+
+	sources := 0
+	for _, set := range []bool{*password != "", passwordFile != "", len(command) > 0} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		diags.AddAttributeError(path.Root("password"), "Conflicting password",
+			"Only one of password, password_file and credential_command, or the PEXIP_PASSWORD, PEXIP_PASSWORD_FILE "+
+				"and PEXIP_CREDENTIAL_COMMAND environment variables, may be set.")
+		return false, false
+	}
+
+	switch {
+	case passwordFile != "":
+		value, err := readPasswordFile(passwordFile)
+		if err != nil {
+			diags.AddAttributeError(path.Root("password_file"), "Failed to read password file",
+				fmt.Sprintf("Could not read password file %q: %s", passwordFile, err))
+			return false, false
+		}
+		*password = value
+		return false, true
+	case len(command) > 0:
+		credentials, err := p.commandCredentials(ctx, command)
+		if err != nil {
+			diags.AddAttributeError(path.Root("credential_command"), "Credential command failed",
+				fmt.Sprintf("Could not get credentials from the credential command: %s", err))
+			return false, false
+		}
+		*password = credentials.Password
+		if credentials.Username != "" {
+			*username = credentials.Username
+			return true, true
+		}
+		return false, true
+	}
+	return false, false
+}
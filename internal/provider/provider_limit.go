@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/pexip/terraform-provider-pexip/internal/transport"
+)
+
+const resourceTypePrefix = "pexip_infinity_"
+
+// limitPolicy builds the concurrency and rate limits for the shared client from
+// the provider configuration, falling back to the PEXIP_* environment
+// variables. Resource types in serialize_writes are mapped to the API endpoint
+// of the same name, e.g. pexip_infinity_worker_vm to worker_vm. They must be
+// in resourceTypes, the resource types the provider registers.
+func (m *PexipProviderModel) limitPolicy(ctx context.Context, resourceTypes map[string]bool) (transport.LimitPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var policy transport.LimitPolicy
+
+	if maxConcurrent, ok := int64ValueOrEnv(m.MaxConcurrentRequests, "PEXIP_MAX_CONCURRENT_REQUESTS", "max_concurrent_requests", &diags); ok {
+		if maxConcurrent < 0 {
+			diags.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid PEXIP_MAX_CONCURRENT_REQUESTS value",
+				fmt.Sprintf("PEXIP_MAX_CONCURRENT_REQUESTS must not be negative, got %d", maxConcurrent))
+		}
+		policy.MaxConcurrentRequests = int(maxConcurrent)
+	}
+	if perSecond, ok := int64ValueOrEnv(m.MaxRequestsPerSecond, "PEXIP_MAX_REQUESTS_PER_SECOND", "max_requests_per_second", &diags); ok {
+		if perSecond < 0 {
+			diags.AddAttributeError(path.Root("max_requests_per_second"), "Invalid PEXIP_MAX_REQUESTS_PER_SECOND value",
+				fmt.Sprintf("PEXIP_MAX_REQUESTS_PER_SECOND must not be negative, got %d", perSecond))
+		}
+		policy.RequestsPerSecond = int(perSecond)
+	}
+
+	var serialized []string
+	fromEnv := false
+	if !m.SerializeWrites.IsNull() {
+		diags.Append(m.SerializeWrites.ElementsAs(ctx, &serialized, false)...)
+	} else if val := os.Getenv("PEXIP_SERIALIZE_WRITES"); val != "" {
+		fromEnv = true
+		for _, field := range strings.Split(val, ",") {
+			resourceType := strings.TrimSpace(field)
+			if !strings.HasPrefix(resourceType, resourceTypePrefix) {
+				diags.AddAttributeError(path.Root("serialize_writes"), "Invalid PEXIP_SERIALIZE_WRITES value",
+					fmt.Sprintf("PEXIP_SERIALIZE_WRITES=%q must be a comma separated list of resource types, e.g. pexip_infinity_worker_vm", val))
+				break
+			}
+			serialized = append(serialized, resourceType)
+		}
+	}
+	for i, resourceType := range serialized {
+		if !resourceTypes[resourceType] {
+			attributePath := path.Root("serialize_writes").AtListIndex(i)
+			summary := "Unknown resource type"
+			if fromEnv {
+				attributePath = path.Root("serialize_writes")
+				summary = "Invalid PEXIP_SERIALIZE_WRITES value"
+			}
+			diags.AddAttributeError(attributePath, summary,
+				fmt.Sprintf("%q is not a resource type of this provider, so its writes cannot be serialized.", resourceType))
+			continue
+		}
+		policy.SerializeEndpoints = append(policy.SerializeEndpoints, strings.TrimPrefix(resourceType, resourceTypePrefix))
+	}
+
+	return policy, diags
+}
+
+// resourceTypes returns the type names of the resources the provider
+// registers, e.g. pexip_infinity_worker_vm.
+func (p *PexipProvider) resourceTypes(ctx context.Context) map[string]bool {
+	providerMetadata := &provider.MetadataResponse{}
+	p.Metadata(ctx, provider.MetadataRequest{}, providerMetadata)
+
+	names := map[string]bool{}
+	for _, newResource := range p.Resources(ctx) {
+		resp := &resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerMetadata.TypeName}, resp)
+		names[resp.TypeName] = true
+	}
+	return names
+}
@@ -0,0 +1,93 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/pexip/terraform-provider-pexip/internal/log"
+)
+
+// loggingTransport wraps base in a transport that logs API requests and
+// responses to the http tflog subsystem, if TF_LOG asks for DEBUG or TRACE
+// output. The values of every sensitive attribute in the provider's schemas are
+// redacted from the logged bodies, since the attribute names match the API
+// field names.
+func (p *PexipProvider) loggingTransport(ctx context.Context, base http.RoundTripper, maxBodyLength int) http.RoundTripper {
+	if !log.HTTPLoggingEnabled() {
+		return base
+	}
+	return &log.LoggingTransport{
+		Base:          base,
+		MaxBodyLength: maxBodyLength,
+		RedactFields:  p.sensitiveAttributes(ctx),
+	}
+}
+
+// sensitiveAttributes returns the names of the sensitive attributes of the
+// provider, resource, data source and action schemas, including those nested
+// in other attributes and blocks. The schemas are read in their protocol form,
+// which is the same for every kind of schema.
+func (p *PexipProvider) sensitiveAttributes(ctx context.Context) []string {
+	names := map[string]bool{}
+
+	schemas, err := providerserver.NewProtocol6(p)().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil
+	}
+	addSensitiveAttributes(names, schemas.Provider)
+	for _, schema := range schemas.ResourceSchemas {
+		addSensitiveAttributes(names, schema)
+	}
+	for _, schema := range schemas.DataSourceSchemas {
+		addSensitiveAttributes(names, schema)
+	}
+	for _, schema := range schemas.ActionSchemas {
+		addSensitiveAttributes(names, schema.Schema)
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func addSensitiveAttributes(names map[string]bool, schema *tfprotov6.Schema) {
+	if schema != nil {
+		addSensitiveBlockAttributes(names, schema.Block)
+	}
+}
+
+func addSensitiveBlockAttributes(names map[string]bool, block *tfprotov6.SchemaBlock) {
+	if block == nil {
+		return
+	}
+	for _, attribute := range block.Attributes {
+		addSensitiveAttribute(names, attribute)
+	}
+	for _, nested := range block.BlockTypes {
+		addSensitiveBlockAttributes(names, nested.Block)
+	}
+}
+
+func addSensitiveAttribute(names map[string]bool, attribute *tfprotov6.SchemaAttribute) {
+	if attribute.Sensitive {
+		names[attribute.Name] = true
+	}
+	if attribute.NestedType != nil {
+		for _, nested := range attribute.NestedType.Attributes {
+			addSensitiveAttribute(names, nested)
+		}
+	}
+}
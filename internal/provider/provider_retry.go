@@ -0,0 +1,72 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/pexip/terraform-provider-pexip/internal/transport"
+)
+
+// retryPolicy builds the retry policy for the shared client from the provider
+// configuration, falling back to the PEXIP_* environment variables and then to
+// the transport defaults.
+func (m *PexipProviderModel) retryPolicy(ctx context.Context) (transport.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := transport.DefaultRetryPolicy()
+
+	if maxRetries, ok := int64ValueOrEnv(m.MaxRetries, "PEXIP_MAX_RETRIES", "max_retries", &diags); ok {
+		if maxRetries < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "Invalid PEXIP_MAX_RETRIES value",
+				fmt.Sprintf("PEXIP_MAX_RETRIES must not be negative, got %d", maxRetries))
+		}
+		policy.MaxRetries = int(maxRetries)
+	}
+	if wait, ok := durationValueOrEnv(m.RetryWaitMin, "PEXIP_RETRY_WAIT_MIN", "retry_wait_min", &diags); ok {
+		policy.WaitMin = wait
+	}
+	if wait, ok := durationValueOrEnv(m.RetryWaitMax, "PEXIP_RETRY_WAIT_MAX", "retry_wait_max", &diags); ok {
+		policy.WaitMax = wait
+	}
+	if timeout, ok := durationValueOrEnv(m.RequestTimeout, "PEXIP_REQUEST_TIMEOUT", "request_timeout", &diags); ok {
+		policy.RequestTimeout = timeout
+	}
+
+	if !m.RetryStatusCodes.IsNull() {
+		var codes []int64
+		diags.Append(m.RetryStatusCodes.ElementsAs(ctx, &codes, false)...)
+		policy.RetryStatusCodes = make([]int, 0, len(codes))
+		for _, code := range codes {
+			policy.RetryStatusCodes = append(policy.RetryStatusCodes, int(code))
+		}
+	} else if val := os.Getenv("PEXIP_RETRY_STATUS_CODES"); val != "" {
+		policy.RetryStatusCodes = nil
+		for _, field := range strings.Split(val, ",") {
+			code, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil || code < 400 || code > 599 {
+				diags.AddAttributeError(path.Root("retry_status_codes"), "Invalid PEXIP_RETRY_STATUS_CODES value",
+					fmt.Sprintf("PEXIP_RETRY_STATUS_CODES=%q must be a comma separated list of HTTP status codes between 400 and 599", val))
+				break
+			}
+			policy.RetryStatusCodes = append(policy.RetryStatusCodes, code)
+		}
+	}
+
+	if policy.WaitMin > policy.WaitMax {
+		diags.AddAttributeError(path.Root("retry_wait_min"), "Invalid retry wait",
+			fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s)", policy.WaitMin, policy.WaitMax))
+	}
+
+	return policy, diags
+}
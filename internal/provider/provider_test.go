@@ -7,12 +7,35 @@
 package provider
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
 )
 
 func getTestProtoV6ProviderFactories(client InfinityClient) map[string]func() (tfprotov6.ProviderServer, error) {
@@ -27,3 +50,555 @@ func TestMain(m *testing.M) {
 	}
 	resource.TestMain(m)
 }
+
+// configureTestProvider runs Configure on p with the given provider
+// configuration. Attributes that are not in attrs are left null.
+func configureTestProvider(t *testing.T, p *PexipProvider, attrs map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+
+	clearProviderEnv(t)
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if value, ok := attrs[name]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(objectType, values),
+			Schema: schemaResp.Schema,
+		},
+	}, resp)
+	return resp.Diagnostics
+}
+
+// readTestDataSource runs Read on d with the given configuration and returns
+// the resulting state. Attributes that are not in attrs are left null.
+func readTestDataSource(t *testing.T, d datasource.DataSource, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if value, ok := attrs[name]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	config := tfsdk.Config{Raw: tftypes.NewValue(objectType, values), Schema: schemaResp.Schema}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Raw: config.Raw, Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	return resp.State, resp.Diagnostics
+}
+
+// invokeTestAction runs Invoke on a with the given configuration and returns
+// the diagnostics and the progress messages it sent. Attributes that are not in
+// attrs are left null.
+func invokeTestAction(t *testing.T, a action.Action, attrs map[string]tftypes.Value) (diag.Diagnostics, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if value, ok := attrs[name]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	var progress []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Raw: tftypes.NewValue(objectType, values), Schema: schemaResp.Schema},
+	}, resp)
+	return resp.Diagnostics, progress
+}
+
+// clearProviderEnv makes sure the PEXIP_* environment of the machine running
+// the tests does not leak into the provider configuration.
+func clearProviderEnv(t *testing.T) {
+	t.Helper()
+	for _, env := range os.Environ() {
+		if key, _, _ := strings.Cut(env, "="); strings.HasPrefix(key, "PEXIP_") {
+			t.Setenv(key, "")
+		}
+	}
+}
+
+func TestPexipProvider_ConfigureMissingCredentials(t *testing.T) {
+	p := New().(*PexipProvider)
+	diags := configureTestProvider(t, p, map[string]tftypes.Value{
+		"address": tftypes.NewValue(tftypes.String, "https://manager.example.com"),
+	})
+	require.True(t, diags.HasError())
+	require.Len(t, diags.Errors(), 2)
+	require.Equal(t, "Missing username", diags.Errors()[0].Summary())
+	require.Equal(t, "Missing password", diags.Errors()[1].Summary())
+	require.Nil(t, p.client)
+}
+
+func TestPexipProvider_ConfigureOAuth2(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+
+	tokenRequests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token/":
+			tokenRequests++
+			_ = r.ParseForm()
+			if r.PostForm.Get("client_assertion") == "" {
+				http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "abc123", "token_type": "Bearer", "expires_in": 3600}`))
+		case "/api/admin/configuration/v1/dns_server/1/":
+			if r.Header.Get("Authorization") != "Bearer abc123" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id": 1, "address": "192.0.2.53", "resource_uri": "/api/admin/configuration/v1/dns_server/1/"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	p := New().(*PexipProvider)
+	diags := configureTestProvider(t, p, map[string]tftypes.Value{
+		"address":            tftypes.NewValue(tftypes.String, server.URL),
+		"insecure":           tftypes.NewValue(tftypes.Bool, true),
+		"oauth2_client_id":   tftypes.NewValue(tftypes.String, "client-id"),
+		"oauth2_private_key": tftypes.NewValue(tftypes.String, string(keyPEM)),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	for i := 0; i < 2; i++ {
+		dns, err := p.client.Config().GetDNSServer(t.Context(), 1)
+		require.NoError(t, err)
+		require.Equal(t, "192.0.2.53", dns.Address)
+	}
+	require.Equal(t, 1, tokenRequests)
+}
+
+func TestPexipProvider_ConfigureOAuth2MissingKey(t *testing.T) {
+	p := New().(*PexipProvider)
+	diags := configureTestProvider(t, p, map[string]tftypes.Value{
+		"address":          tftypes.NewValue(tftypes.String, "https://manager.example.com"),
+		"oauth2_client_id": tftypes.NewValue(tftypes.String, "client-id"),
+	})
+	require.True(t, diags.HasError())
+	require.Len(t, diags.Errors(), 1)
+	require.Equal(t, "Missing OAuth2 private key", diags.Errors()[0].Summary())
+}
+
+func TestPexipProviderModel_OAuth2ClientID(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv("PEXIP_OAUTH2_CLIENT_ID", "env-client")
+
+	data := PexipProviderModel{
+		Username:          types.StringNull(),
+		Password:          types.StringNull(),
+		PasswordFile:      types.StringNull(),
+		CredentialCommand: types.ListNull(types.StringType),
+		OAuth2ClientID:    types.StringNull(),
+	}
+	require.Equal(t, "env-client", data.oauth2ClientID())
+
+	withPassword := data
+	withPassword.Username = types.StringValue("admin")
+	withPassword.Password = types.StringValue("admin123")
+	require.Empty(t, withPassword.oauth2ClientID())
+
+	withPasswordFile := data
+	withPasswordFile.PasswordFile = types.StringValue("/run/secrets/pexip")
+	require.Empty(t, withPasswordFile.oauth2ClientID())
+
+	withClientID := withPassword
+	withClientID.OAuth2ClientID = types.StringValue("config-client")
+	require.Equal(t, "config-client", withClientID.oauth2ClientID())
+}
+
+func generateClientCertificate(t *testing.T) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestPexipProvider_ConfigureClientCertificate(t *testing.T) {
+	clearProviderEnv(t)
+	certPEM, keyPEM := generateClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(certPEM))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" || len(r.TLS.PeerCertificates) == 0 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 1, "address": "192.0.2.53", "resource_uri": "/api/admin/configuration/v1/dns_server/1/"}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	// The server certificate is trusted via ca_certificate rather than insecure
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	p := New().(*PexipProvider)
+	diags := configureTestProvider(t, p, map[string]tftypes.Value{
+		"address":            tftypes.NewValue(tftypes.String, server.URL),
+		"ca_certificate":     tftypes.NewValue(tftypes.String, string(caPEM)),
+		"client_certificate": tftypes.NewValue(tftypes.String, string(certPEM)),
+		"client_key":         tftypes.NewValue(tftypes.String, string(keyPEM)),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	dns, err := p.client.Config().GetDNSServer(t.Context(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "192.0.2.53", dns.Address)
+}
+
+func TestPexipProvider_ConfigureInvalidTLS(t *testing.T) {
+	clearProviderEnv(t)
+	certPEM, _ := generateClientCertificate(t)
+
+	tests := []struct {
+		name    string
+		attrs   map[string]tftypes.Value
+		summary string
+	}{
+		{
+			name: "invalid CA certificate",
+			attrs: map[string]tftypes.Value{
+				"ca_certificate": tftypes.NewValue(tftypes.String, "not a certificate"),
+			},
+			summary: "Invalid CA certificate",
+		},
+		{
+			name: "missing CA file",
+			attrs: map[string]tftypes.Value{
+				"ca_file": tftypes.NewValue(tftypes.String, t.TempDir()+"/missing.pem"),
+			},
+			summary: "Failed to read CA file",
+		},
+		{
+			name: "client certificate without key",
+			attrs: map[string]tftypes.Value{
+				"client_certificate": tftypes.NewValue(tftypes.String, string(certPEM)),
+			},
+			summary: "Missing client key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.attrs["address"] = tftypes.NewValue(tftypes.String, "https://manager.example.com")
+			tt.attrs["username"] = tftypes.NewValue(tftypes.String, "admin")
+			tt.attrs["password"] = tftypes.NewValue(tftypes.String, "password")
+			diags := configureTestProvider(t, New().(*PexipProvider), tt.attrs)
+			require.Len(t, diags.Errors(), 1, "%v", diags)
+			require.Equal(t, tt.summary, diags.Errors()[0].Summary())
+		})
+	}
+}
+
+func TestPexipProviderModel_RetryPolicy(t *testing.T) {
+	clearProviderEnv(t)
+
+	data := &PexipProviderModel{
+		MaxRetries:       types.Int64Value(5),
+		RetryWaitMin:     types.StringValue("500ms"),
+		RetryWaitMax:     types.StringNull(),
+		RetryStatusCodes: types.ListNull(types.Int64Type),
+		RequestTimeout:   types.StringNull(),
+	}
+	t.Setenv("PEXIP_RETRY_WAIT_MAX", "1m")
+	t.Setenv("PEXIP_REQUEST_TIMEOUT", "2m")
+	t.Setenv("PEXIP_RETRY_STATUS_CODES", "502, 503")
+
+	policy, diags := data.retryPolicy(t.Context())
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, 5, policy.MaxRetries)
+	require.Equal(t, 500*time.Millisecond, policy.WaitMin)
+	require.Equal(t, time.Minute, policy.WaitMax)
+	require.Equal(t, 2*time.Minute, policy.RequestTimeout)
+	require.Equal(t, []int{502, 503}, policy.RetryStatusCodes)
+
+	t.Setenv("PEXIP_RETRY_STATUS_CODES", "502,ok")
+	t.Setenv("PEXIP_RETRY_WAIT_MAX", "100ms")
+	_, diags = data.retryPolicy(t.Context())
+	require.Len(t, diags.Errors(), 2)
+	require.Equal(t, "Invalid PEXIP_RETRY_STATUS_CODES value", diags.Errors()[0].Summary())
+	require.Equal(t, "Invalid retry wait", diags.Errors()[1].Summary())
+}
+
+func TestPexipProviderModel_LimitPolicy(t *testing.T) {
+	clearProviderEnv(t)
+
+	resourceTypes := New().(*PexipProvider).resourceTypes(t.Context())
+	require.True(t, resourceTypes["pexip_infinity_worker_vm"])
+
+	data := &PexipProviderModel{
+		MaxConcurrentRequests: types.Int64Value(4),
+		MaxRequestsPerSecond:  types.Int64Null(),
+		SerializeWrites:       types.ListNull(types.StringType),
+	}
+	t.Setenv("PEXIP_MAX_REQUESTS_PER_SECOND", "10")
+	t.Setenv("PEXIP_SERIALIZE_WRITES", "pexip_infinity_system_location, pexip_infinity_worker_vm")
+
+	policy, diags := data.limitPolicy(t.Context(), resourceTypes)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, 4, policy.MaxConcurrentRequests)
+	require.Equal(t, 10, policy.RequestsPerSecond)
+	require.Equal(t, []string{"system_location", "worker_vm"}, policy.SerializeEndpoints)
+
+	t.Setenv("PEXIP_SERIALIZE_WRITES", "worker_vm")
+	_, diags = data.limitPolicy(t.Context(), resourceTypes)
+	require.Len(t, diags.Errors(), 1)
+	require.Equal(t, "Invalid PEXIP_SERIALIZE_WRITES value", diags.Errors()[0].Summary())
+
+	t.Setenv("PEXIP_SERIALIZE_WRITES", "pexip_infinity_worker")
+	_, diags = data.limitPolicy(t.Context(), resourceTypes)
+	require.Len(t, diags.Errors(), 1)
+	require.Equal(t, "Invalid PEXIP_SERIALIZE_WRITES value", diags.Errors()[0].Summary())
+
+	data.SerializeWrites = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("pexip_infinity_worker_vm"), types.StringValue("pexip_infinity_worker")})
+	_, diags = data.limitPolicy(t.Context(), resourceTypes)
+	require.Len(t, diags.Errors(), 1)
+	require.Equal(t, "Unknown resource type", diags.Errors()[0].Summary())
+	require.Equal(t, path.Root("serialize_writes").AtListIndex(1), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+}
+
+func TestPexipProvider_SensitiveAttributes(t *testing.T) {
+	names := New().(*PexipProvider).sensitiveAttributes(t.Context())
+
+	// Provider, resource and data source attributes that hold credentials
+	for _, name := range []string{"password", "client_key", "oauth2_private_key", "private_key", "ldap_bind_password", "client_secret"} {
+		require.Contains(t, names, name)
+	}
+	require.NotContains(t, names, "name")
+	require.NotContains(t, names, "address")
+}
+
+func TestAddSensitiveAttributes(t *testing.T) {
+	// Sensitive attributes nested in attributes and blocks are found too
+	names := map[string]bool{}
+	addSensitiveAttributes(names, &tfprotov6.Schema{
+		Block: &tfprotov6.SchemaBlock{
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{Name: "name"},
+				{Name: "password", Sensitive: true},
+				{Name: "credentials", NestedType: &tfprotov6.SchemaObject{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{Name: "username"},
+						{Name: "secret", Sensitive: true},
+					},
+				}},
+			},
+			BlockTypes: []*tfprotov6.SchemaNestedBlock{
+				{TypeName: "tls", Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{Name: "private_key", Sensitive: true},
+					},
+				}},
+			},
+		},
+	})
+	require.Equal(t, map[string]bool{"password": true, "secret": true, "private_key": true}, names)
+}
+
+// basicAuthServer returns a server that only accepts requests with the given
+// basic auth credentials.
+func basicAuthServer(t *testing.T, username, password string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != username || p != password {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 1, "address": "192.0.2.53", "resource_uri": "/api/admin/configuration/v1/dns_server/1/"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPexipProvider_ConfigurePasswordFile(t *testing.T) {
+	server := basicAuthServer(t, "admin", "file-password")
+	passwordFile := t.TempDir() + "/password"
+	require.NoError(t, os.WriteFile(passwordFile, []byte("file-password\n"), 0o600))
+
+	p := New().(*PexipProvider)
+	diags := configureTestProvider(t, p, map[string]tftypes.Value{
+		"address":       tftypes.NewValue(tftypes.String, server.URL),
+		"insecure":      tftypes.NewValue(tftypes.Bool, true),
+		"username":      tftypes.NewValue(tftypes.String, "admin"),
+		"password_file": tftypes.NewValue(tftypes.String, passwordFile),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	dns, err := p.client.Config().GetDNSServer(t.Context(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "192.0.2.53", dns.Address)
+}
+
+func TestPexipProvider_ConfigureCredentialCommand(t *testing.T) {
+	server := basicAuthServer(t, "terraform", "short-lived")
+
+	// The script counts how often it runs
+	dir := t.TempDir()
+	script := dir + "/credentials.sh"
+	require.NoError(t, os.WriteFile(script, []byte(`#!/bin/sh
+echo run >> "$1"
+echo '{"username": "terraform", "password": "short-lived"}'
+`), 0o700)) // #nosec G306 -- The test script must be executable
+	command := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, script),
+		tftypes.NewValue(tftypes.String, dir+"/runs"),
+	})
+
+	p := New().(*PexipProvider)
+	for i := 0; i < 2; i++ {
+		diags := configureTestProvider(t, p, map[string]tftypes.Value{
+			"address":            tftypes.NewValue(tftypes.String, server.URL),
+			"insecure":           tftypes.NewValue(tftypes.Bool, true),
+			"username":           tftypes.NewValue(tftypes.String, "admin"),
+			"credential_command": command,
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+	}
+
+	dns, err := p.client.Config().GetDNSServer(t.Context(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "192.0.2.53", dns.Address)
+
+	runs, err := os.ReadFile(dir + "/runs")
+	require.NoError(t, err)
+	require.Equal(t, "run\n", string(runs))
+}
+
+func TestPexipProvider_ConfigureInvalidCredentialSource(t *testing.T) {
+	dir := t.TempDir()
+	failing := dir + "/failing.sh"
+	require.NoError(t, os.WriteFile(failing, []byte("#!/bin/sh\necho 'token expired' >&2\nexit 3\n"), 0o700)) // #nosec G306 -- The test script must be executable
+	noPassword := dir + "/no-password.sh"
+	require.NoError(t, os.WriteFile(noPassword, []byte("#!/bin/sh\necho '{\"username\": \"admin\"}'\n"), 0o700)) // #nosec G306 -- The test script must be executable
+
+	tests := []struct {
+		name    string
+		attrs   map[string]tftypes.Value
+		summary string
+		detail  string
+	}{
+		{
+			name: "missing password file",
+			attrs: map[string]tftypes.Value{
+				"password_file": tftypes.NewValue(tftypes.String, dir+"/missing"),
+			},
+			summary: "Failed to read password file",
+		},
+		{
+			name: "failing command",
+			attrs: map[string]tftypes.Value{
+				"credential_command": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, failing)}),
+			},
+			summary: "Credential command failed",
+			detail:  "exit status 3: token expired",
+		},
+		{
+			name: "command without password",
+			attrs: map[string]tftypes.Value{
+				"credential_command": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, noPassword)}),
+			},
+			summary: "Credential command failed",
+			detail:  "did not print a password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.attrs["address"] = tftypes.NewValue(tftypes.String, "https://manager.example.com")
+			tt.attrs["username"] = tftypes.NewValue(tftypes.String, "admin")
+
+			p := New().(*PexipProvider)
+			diags := configureTestProvider(t, p, tt.attrs)
+			require.Len(t, diags.Errors(), 1, "%v", diags)
+			require.Equal(t, tt.summary, diags.Errors()[0].Summary())
+			require.Contains(t, diags.Errors()[0].Detail(), tt.detail)
+			require.Nil(t, p.client)
+		})
+	}
+}
+
+func TestPexipProvider_ResolvePasswordFromEnv(t *testing.T) {
+	clearProviderEnv(t)
+	passwordFile := t.TempDir() + "/password"
+	require.NoError(t, os.WriteFile(passwordFile, []byte("file-password"), 0o600))
+	t.Setenv("PEXIP_PASSWORD", "env-password")
+	t.Setenv("PEXIP_PASSWORD_FILE", passwordFile)
+
+	data := &PexipProviderModel{
+		Password:          types.StringNull(),
+		PasswordFile:      types.StringNull(),
+		CredentialCommand: types.ListNull(types.StringType),
+	}
+
+	// The environment sets two passwords
+	var diags diag.Diagnostics
+	username, password := "admin", "env-password"
+	New().(*PexipProvider).resolvePassword(t.Context(), data, &username, &password, &diags)
+	require.Len(t, diags.Errors(), 1)
+	require.Equal(t, "Conflicting password", diags.Errors()[0].Summary())
+
+	// A password file in the provider config takes precedence over PEXIP_PASSWORD
+	diags = nil
+	data.PasswordFile = types.StringValue(passwordFile)
+	_, replaced := New().(*PexipProvider).resolvePassword(t.Context(), data, &username, &password, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.True(t, replaced)
+	require.Equal(t, "file-password", password)
+}
@@ -0,0 +1,74 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// tlsConfig builds the TLS configuration for the Manager connection. CA
+// certificates from ca_certificate and ca_file are added to the system root
+// pool, and client_certificate and client_key are presented for mutual TLS.
+func (m *PexipProviderModel) tlsConfig(insecure bool) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := &tls.Config{
+		InsecureSkipVerify: insecure, // #nosec G402 -- This is intentionally configurable for testing environments
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	caCertificate, _ := stringValueOrEnv(m.CACertificate, "PEXIP_CA_CERTIFICATE")
+	caFile, _ := stringValueOrEnv(m.CAFile, "PEXIP_CA_FILE")
+	if caCertificate != "" || caFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if caCertificate != "" && !pool.AppendCertsFromPEM([]byte(caCertificate)) {
+			diags.AddAttributeError(path.Root("ca_certificate"), "Invalid CA certificate",
+				"No PEM encoded certificates could be parsed from ca_certificate.")
+		}
+		if caFile != "" {
+			data, err := os.ReadFile(caFile) // #nosec G304 -- Path is provided by the operator
+			if err != nil {
+				diags.AddAttributeError(path.Root("ca_file"), "Failed to read CA file",
+					fmt.Sprintf("Could not read CA file %q: %s", caFile, err))
+			} else if !pool.AppendCertsFromPEM(data) {
+				diags.AddAttributeError(path.Root("ca_file"), "Invalid CA file",
+					fmt.Sprintf("No PEM encoded certificates could be parsed from %q.", caFile))
+			}
+		}
+		config.RootCAs = pool
+	}
+
+	clientCertificate, _ := stringValueOrEnv(m.ClientCertificate, "PEXIP_CLIENT_CERTIFICATE")
+	clientKey, _ := stringValueOrEnv(m.ClientKey, "PEXIP_CLIENT_KEY")
+	switch {
+	case clientCertificate != "" && clientKey == "":
+		diags.AddAttributeError(path.Root("client_key"), "Missing client key",
+			"Expected client_key to be set in provider config or via the PEXIP_CLIENT_KEY environment variable when client_certificate is set.")
+	case clientCertificate == "" && clientKey != "":
+		diags.AddAttributeError(path.Root("client_certificate"), "Missing client certificate",
+			"Expected client_certificate to be set in provider config or via the PEXIP_CLIENT_CERTIFICATE environment variable when client_key is set.")
+	case clientCertificate != "":
+		certificate, err := tls.X509KeyPair([]byte(clientCertificate), []byte(clientKey))
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_certificate"), "Invalid client certificate",
+				fmt.Sprintf("Could not load client certificate and key: %s", err))
+			break
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, diags
+}
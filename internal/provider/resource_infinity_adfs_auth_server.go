@@ -132,6 +132,10 @@ func (r *InfinityADFSAuthServerResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityADFSAuthServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity ADFS auth server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityADFSAuthServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -154,7 +158,7 @@ func (r *InfinityADFSAuthServerResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateADFSAuthServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity ADFS auth server",
 			fmt.Sprintf("Could not create Infinity ADFS auth server: %s", err),
 		)
@@ -193,7 +197,7 @@ func (r *InfinityADFSAuthServerResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("ADFS auth server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("ADFS auth server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -239,6 +243,10 @@ func (r *InfinityADFSAuthServerResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityADFSAuthServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity ADFS auth server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityADFSAuthServerResourceModel{}
 	state := &InfinityADFSAuthServerResourceModel{}
 
@@ -265,7 +273,7 @@ func (r *InfinityADFSAuthServerResource) Update(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateADFSAuthServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity ADFS auth server",
 			fmt.Sprintf("Could not update Infinity ADFS auth server with ID %d: %s", resourceID, err),
 		)
@@ -286,6 +294,10 @@ func (r *InfinityADFSAuthServerResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityADFSAuthServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity ADFS auth server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityADFSAuthServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity ADFS auth server")
@@ -297,8 +309,8 @@ func (r *InfinityADFSAuthServerResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteADFSAuthServer(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity ADFS auth server",
 			fmt.Sprintf("Could not delete Infinity ADFS auth server with ID %s: %s", state.ID.ValueString(), err),
@@ -365,6 +365,10 @@ func (r *InfinityAuthenticationResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityAuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity authentication configuration", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, Create is actually Update since the resource always exists
 	plan := &InfinityAuthenticationResourceModel{}
 
@@ -377,7 +381,7 @@ func (r *InfinityAuthenticationResource) Create(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateAuthentication(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity authentication configuration",
 			fmt.Sprintf("Could not update Infinity authentication configuration: %s", err),
 		)
@@ -456,7 +460,7 @@ func (r *InfinityAuthenticationResource) read(ctx context.Context, ldapPass, oid
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("authentication configuration not found")
+		return nil, notFoundErrorf("authentication configuration not found")
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -523,6 +527,10 @@ func (r *InfinityAuthenticationResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityAuthenticationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity authentication configuration", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityAuthenticationResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -534,7 +542,7 @@ func (r *InfinityAuthenticationResource) Update(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateAuthentication(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity authentication configuration",
 			fmt.Sprintf("Could not update Infinity authentication configuration: %s", err),
 		)
@@ -555,6 +563,10 @@ func (r *InfinityAuthenticationResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityAuthenticationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity authentication configuration", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, delete means resetting all fields to their API defaults.
 	tflog.Info(ctx, "Resetting Infinity authentication configuration to defaults")
 
@@ -598,7 +610,7 @@ func (r *InfinityAuthenticationResource) Delete(ctx context.Context, req resourc
 	}
 
 	_, err := r.InfinityClient.Config().UpdateAuthentication(ctx, updateRequest)
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Resetting Infinity authentication configuration",
 			fmt.Sprintf("Could not reset Infinity authentication configuration: %s", err),
@@ -186,7 +186,7 @@ func (r *InfinityAutobackupResource) read(ctx context.Context, passphrase, uploa
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("autobackup configuration not found")
+		return nil, notFoundErrorf("autobackup configuration not found")
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -204,6 +204,10 @@ func (r *InfinityAutobackupResource) read(ctx context.Context, passphrase, uploa
 }
 
 func (r *InfinityAutobackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity autobackup configuration", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, Create is actually Update since the resource always exists
 	plan := &InfinityAutobackupResourceModel{}
 
@@ -216,7 +220,7 @@ func (r *InfinityAutobackupResource) Create(ctx context.Context, req resource.Cr
 
 	_, err := r.InfinityClient.Config().UpdateAutobackup(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity autobackup configuration",
 			fmt.Sprintf("Could not update Infinity autobackup configuration: %s", err),
 		)
@@ -260,6 +264,10 @@ func (r *InfinityAutobackupResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *InfinityAutobackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity autobackup configuration", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityAutobackupResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -271,7 +279,7 @@ func (r *InfinityAutobackupResource) Update(ctx context.Context, req resource.Up
 
 	_, err := r.InfinityClient.Config().UpdateAutobackup(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity autobackup configuration",
 			fmt.Sprintf("Could not update Infinity autobackup configuration: %s", err),
 		)
@@ -291,6 +299,10 @@ func (r *InfinityAutobackupResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *InfinityAutobackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity autobackup configuration", &resp.Diagnostics) {
+		return
+	}
+
 	tflog.Info(ctx, "Resetting Infinity autobackup configuration to defaults")
 
 	enabled := false
@@ -308,7 +320,7 @@ func (r *InfinityAutobackupResource) Delete(ctx context.Context, req resource.De
 	}
 
 	_, err := r.InfinityClient.Config().UpdateAutobackup(ctx, updateRequest)
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Resetting Infinity autobackup configuration",
 			fmt.Sprintf("Could not reset Infinity autobackup configuration: %s", err),
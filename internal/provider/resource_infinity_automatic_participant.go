@@ -199,6 +199,10 @@ func (r *InfinityAutomaticParticipantResource) Schema(ctx context.Context, req r
 }
 
 func (r *InfinityAutomaticParticipantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity automatic participant", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityAutomaticParticipantResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -240,7 +244,7 @@ func (r *InfinityAutomaticParticipantResource) Create(ctx context.Context, req r
 
 	createResponse, err := r.InfinityClient.Config().CreateAutomaticParticipant(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity automatic participant",
 			fmt.Sprintf("Could not create Infinity automatic participant: %s", err),
 		)
@@ -279,7 +283,7 @@ func (r *InfinityAutomaticParticipantResource) read(ctx context.Context, resourc
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("automatic participant with ID %d not found", resourceID)
+		return nil, notFoundErrorf("automatic participant with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -342,6 +346,10 @@ func (r *InfinityAutomaticParticipantResource) Read(ctx context.Context, req res
 }
 
 func (r *InfinityAutomaticParticipantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity automatic participant", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityAutomaticParticipantResourceModel{}
 	state := &InfinityAutomaticParticipantResourceModel{}
 	rawConfig := &InfinityAutomaticParticipantResourceModel{}
@@ -398,7 +406,7 @@ func (r *InfinityAutomaticParticipantResource) Update(ctx context.Context, req r
 
 	_, err := r.InfinityClient.Config().UpdateAutomaticParticipant(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity automatic participant",
 			fmt.Sprintf("Could not update Infinity automatic participant with ID %d: %s", resourceID, err),
 		)
@@ -419,6 +427,10 @@ func (r *InfinityAutomaticParticipantResource) Update(ctx context.Context, req r
 }
 
 func (r *InfinityAutomaticParticipantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity automatic participant", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityAutomaticParticipantResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity automatic participant")
@@ -430,8 +442,8 @@ func (r *InfinityAutomaticParticipantResource) Delete(ctx context.Context, req r
 
 	err := r.InfinityClient.Config().DeleteAutomaticParticipant(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity automatic participant",
 			fmt.Sprintf("Could not delete Infinity automatic participant with ID %s: %s", state.ID.ValueString(), err),
@@ -109,6 +109,10 @@ func (r *InfinityAzureTenantResource) Schema(ctx context.Context, req resource.S
 }
 
 func (r *InfinityAzureTenantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity Azure tenant", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityAzureTenantResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -124,7 +128,7 @@ func (r *InfinityAzureTenantResource) Create(ctx context.Context, req resource.C
 
 	createResponse, err := r.InfinityClient.Config().CreateAzureTenant(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity Azure tenant",
 			fmt.Sprintf("Could not create Infinity Azure tenant: %s", err),
 		)
@@ -163,7 +167,7 @@ func (r *InfinityAzureTenantResource) read(ctx context.Context, resourceID int)
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("azure tenant with ID %d not found", resourceID)
+		return nil, notFoundErrorf("azure tenant with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -202,6 +206,10 @@ func (r *InfinityAzureTenantResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *InfinityAzureTenantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity Azure tenant", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityAzureTenantResourceModel{}
 	state := &InfinityAzureTenantResourceModel{}
 
@@ -220,7 +228,7 @@ func (r *InfinityAzureTenantResource) Update(ctx context.Context, req resource.U
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateAzureTenant(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity Azure tenant",
 			fmt.Sprintf("Could not update Infinity Azure tenant: %s", err),
 		)
@@ -241,6 +249,10 @@ func (r *InfinityAzureTenantResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *InfinityAzureTenantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity Azure tenant", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityAzureTenantResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity Azure tenant")
@@ -252,8 +264,8 @@ func (r *InfinityAzureTenantResource) Delete(ctx context.Context, req resource.D
 
 	err := r.InfinityClient.Config().DeleteAzureTenant(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity Azure tenant",
 			fmt.Sprintf("Could not delete Infinity Azure tenant with ID %s: %s", state.ID.ValueString(), err),
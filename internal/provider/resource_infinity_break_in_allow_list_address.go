@@ -143,6 +143,10 @@ func (r *InfinityBreakInAllowListAddressResource) Schema(ctx context.Context, re
 }
 
 func (r *InfinityBreakInAllowListAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity break-in allow list address", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityBreakInAllowListAddressResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -166,7 +170,7 @@ func (r *InfinityBreakInAllowListAddressResource) Create(ctx context.Context, re
 
 	createResponse, err := r.InfinityClient.Config().CreateBreakInAllowListAddress(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity break-in allow list address",
 			fmt.Sprintf("Could not create Infinity break-in allow list address: %s", err),
 		)
@@ -205,7 +209,7 @@ func (r *InfinityBreakInAllowListAddressResource) read(ctx context.Context, reso
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("break-in allow list address with ID %d not found", resourceID)
+		return nil, notFoundErrorf("break-in allow list address with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -248,6 +252,10 @@ func (r *InfinityBreakInAllowListAddressResource) Read(ctx context.Context, req
 }
 
 func (r *InfinityBreakInAllowListAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity break-in allow list address", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityBreakInAllowListAddressResourceModel{}
 	state := &InfinityBreakInAllowListAddressResourceModel{}
 
@@ -287,7 +295,7 @@ func (r *InfinityBreakInAllowListAddressResource) Update(ctx context.Context, re
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateBreakInAllowListAddress(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity break-in allow list address",
 			fmt.Sprintf("Could not update Infinity break-in allow list address: %s", err),
 		)
@@ -308,6 +316,10 @@ func (r *InfinityBreakInAllowListAddressResource) Update(ctx context.Context, re
 }
 
 func (r *InfinityBreakInAllowListAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity break-in allow list address", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityBreakInAllowListAddressResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity break-in allow list address")
@@ -319,8 +331,8 @@ func (r *InfinityBreakInAllowListAddressResource) Delete(ctx context.Context, re
 
 	err := r.InfinityClient.Config().DeleteBreakInAllowListAddress(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity break-in allow list address",
 			fmt.Sprintf("Could not delete Infinity break-in allow list address with ID %s: %s", state.ID.ValueString(), err),
@@ -156,6 +156,10 @@ func (r *InfinityCACertificateResource) Schema(ctx context.Context, req resource
 }
 
 func (r *InfinityCACertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity CA certificate", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityCACertificateResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -170,7 +174,7 @@ func (r *InfinityCACertificateResource) Create(ctx context.Context, req resource
 
 	createResponse, err := r.InfinityClient.Config().CreateCACertificate(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity CA certificate",
 			fmt.Sprintf("Could not create Infinity CA certificate: %s", err),
 		)
@@ -209,7 +213,7 @@ func (r *InfinityCACertificateResource) read(ctx context.Context, resourceID int
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("CA certificate with ID %d not found", resourceID)
+		return nil, notFoundErrorf("CA certificate with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -267,6 +271,10 @@ func (r *InfinityCACertificateResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *InfinityCACertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity CA certificate", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityCACertificateResourceModel{}
 	state := &InfinityCACertificateResourceModel{}
 
@@ -285,7 +293,7 @@ func (r *InfinityCACertificateResource) Update(ctx context.Context, req resource
 
 	_, err := r.InfinityClient.Config().UpdateCACertificate(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity CA certificate",
 			fmt.Sprintf("Could not update Infinity CA certificate with ID %d: %s", resourceID, err),
 		)
@@ -306,6 +314,10 @@ func (r *InfinityCACertificateResource) Update(ctx context.Context, req resource
 }
 
 func (r *InfinityCACertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity CA certificate", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityCACertificateResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity CA certificate")
@@ -317,8 +329,8 @@ func (r *InfinityCACertificateResource) Delete(ctx context.Context, req resource
 
 	err := r.InfinityClient.Config().DeleteCACertificate(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity CA certificate",
 			fmt.Sprintf("Could not delete Infinity CA certificate with ID %s: %s", state.ID.ValueString(), err),
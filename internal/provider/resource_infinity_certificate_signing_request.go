@@ -156,6 +156,10 @@ func (r *InfinityCertificateSigningRequestResource) Schema(ctx context.Context,
 }
 
 func (r *InfinityCertificateSigningRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity certificate signing request", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityCertificateSigningRequestResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -199,7 +203,7 @@ func (r *InfinityCertificateSigningRequestResource) Create(ctx context.Context,
 
 	createResponse, err := r.InfinityClient.Config().CreateCertificateSigningRequest(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity certificate signing request",
 			fmt.Sprintf("Could not create Infinity certificate signing request: %s", err),
 		)
@@ -239,7 +243,7 @@ func (r *InfinityCertificateSigningRequestResource) read(ctx context.Context, re
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("certificate signing request with ID %d not found", resourceID)
+		return nil, notFoundErrorf("certificate signing request with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -296,6 +300,10 @@ func (r *InfinityCertificateSigningRequestResource) Read(ctx context.Context, re
 }
 
 func (r *InfinityCertificateSigningRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity certificate signing request", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityCertificateSigningRequestResourceModel{}
 	state := &InfinityCertificateSigningRequestResourceModel{}
 
@@ -354,7 +362,7 @@ func (r *InfinityCertificateSigningRequestResource) Update(ctx context.Context,
 	var result config.CertificateSigningRequest
 	err := r.InfinityClient.PatchJSON(ctx, endpoint, updateRequest, &result)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity certificate signing request",
 			fmt.Sprintf("Could not update Infinity certificate signing request with ID %d: %s", resourceID, err),
 		)
@@ -376,6 +384,10 @@ func (r *InfinityCertificateSigningRequestResource) Update(ctx context.Context,
 }
 
 func (r *InfinityCertificateSigningRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity certificate signing request", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityCertificateSigningRequestResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity certificate signing request")
@@ -387,8 +399,8 @@ func (r *InfinityCertificateSigningRequestResource) Delete(ctx context.Context,
 
 	err := r.InfinityClient.Config().DeleteCertificateSigningRequest(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity certificate signing request",
 			fmt.Sprintf("Could not delete Infinity certificate signing request with ID %s: %s", state.ID.ValueString(), err),
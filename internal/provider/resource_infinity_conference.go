@@ -489,6 +489,10 @@ func (r *InfinityConferenceResource) Schema(ctx context.Context, req resource.Sc
 }
 
 func (r *InfinityConferenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity conference", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityConferenceResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -660,7 +664,7 @@ func (r *InfinityConferenceResource) Create(ctx context.Context, req resource.Cr
 
 	createResponse, err := r.InfinityClient.Config().CreateConference(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity conference",
 			fmt.Sprintf("Could not create Infinity conference: %s", err),
 		)
@@ -699,7 +703,7 @@ func (r *InfinityConferenceResource) read(ctx context.Context, resourceID int) (
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("conference with ID %d not found", resourceID)
+		return nil, notFoundErrorf("conference with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -820,6 +824,10 @@ func (r *InfinityConferenceResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *InfinityConferenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity conference", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityConferenceResourceModel{}
 	state := &InfinityConferenceResourceModel{}
 
@@ -991,7 +999,7 @@ func (r *InfinityConferenceResource) Update(ctx context.Context, req resource.Up
 
 	_, err := r.InfinityClient.Config().UpdateConference(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity conference",
 			fmt.Sprintf("Could not update Infinity conference with ID %d: %s", resourceID, err),
 		)
@@ -1012,6 +1020,10 @@ func (r *InfinityConferenceResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *InfinityConferenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity conference", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityConferenceResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity conference")
@@ -1023,8 +1035,8 @@ func (r *InfinityConferenceResource) Delete(ctx context.Context, req resource.De
 
 	err := r.InfinityClient.Config().DeleteConference(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity conference",
 			fmt.Sprintf("Could not delete Infinity conference with ID %s: %s", state.ID.ValueString(), err),
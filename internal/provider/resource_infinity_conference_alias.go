@@ -102,6 +102,10 @@ func (r *InfinityConferenceAliasResource) Schema(ctx context.Context, req resour
 }
 
 func (r *InfinityConferenceAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity conference alias", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityConferenceAliasResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -121,7 +125,7 @@ func (r *InfinityConferenceAliasResource) Create(ctx context.Context, req resour
 
 	createResponse, err := r.InfinityClient.Config().CreateConferenceAlias(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity conference alias",
 			fmt.Sprintf("Could not create Infinity conference alias: %s", err),
 		)
@@ -160,7 +164,7 @@ func (r *InfinityConferenceAliasResource) read(ctx context.Context, resourceID i
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("conference alias with ID %d not found", resourceID)
+		return nil, notFoundErrorf("conference alias with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -199,6 +203,10 @@ func (r *InfinityConferenceAliasResource) Read(ctx context.Context, req resource
 }
 
 func (r *InfinityConferenceAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity conference alias", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityConferenceAliasResourceModel{}
 	state := &InfinityConferenceAliasResourceModel{}
 
@@ -218,7 +226,7 @@ func (r *InfinityConferenceAliasResource) Update(ctx context.Context, req resour
 
 	_, err := r.InfinityClient.Config().UpdateConferenceAlias(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity conference alias",
 			fmt.Sprintf("Could not update Infinity conference alias with ID %d: %s", resourceID, err),
 		)
@@ -239,6 +247,10 @@ func (r *InfinityConferenceAliasResource) Update(ctx context.Context, req resour
 }
 
 func (r *InfinityConferenceAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity conference alias", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityConferenceAliasResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity conference alias")
@@ -250,8 +262,8 @@ func (r *InfinityConferenceAliasResource) Delete(ctx context.Context, req resour
 
 	err := r.InfinityClient.Config().DeleteConferenceAlias(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity conference alias",
 			fmt.Sprintf("Could not delete Infinity conference alias with ID %s: %s", state.ID.ValueString(), err),
@@ -179,6 +179,10 @@ func (r *InfinityDeviceResource) Schema(ctx context.Context, req resource.Schema
 }
 
 func (r *InfinityDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity device", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityDeviceResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -251,7 +255,7 @@ func (r *InfinityDeviceResource) Create(ctx context.Context, req resource.Create
 
 	createResponse, err := r.InfinityClient.Config().CreateDevice(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity device",
 			fmt.Sprintf("Could not create Infinity device: %s", err),
 		)
@@ -291,7 +295,7 @@ func (r *InfinityDeviceResource) read(ctx context.Context, resourceID int, passw
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("device with ID %d not found", resourceID)
+		return nil, notFoundErrorf("device with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -349,6 +353,10 @@ func (r *InfinityDeviceResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *InfinityDeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity device", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityDeviceResourceModel{}
 	state := &InfinityDeviceResourceModel{}
 
@@ -402,7 +410,7 @@ func (r *InfinityDeviceResource) Update(ctx context.Context, req resource.Update
 
 	_, err := r.InfinityClient.Config().UpdateDevice(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity device",
 			fmt.Sprintf("Could not update Infinity device with ID %d: %s", resourceID, err),
 		)
@@ -423,6 +431,10 @@ func (r *InfinityDeviceResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *InfinityDeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity device", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityDeviceResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity device")
@@ -434,8 +446,8 @@ func (r *InfinityDeviceResource) Delete(ctx context.Context, req resource.Delete
 
 	err := r.InfinityClient.Config().DeleteDevice(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity device",
 			fmt.Sprintf("Could not delete Infinity device with ID %s: %s", state.ID.ValueString(), err),
@@ -105,6 +105,10 @@ func (r *InfinityDiagnosticGraphResource) Schema(ctx context.Context, req resour
 }
 
 func (r *InfinityDiagnosticGraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity diagnostic graph", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityDiagnosticGraphResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -129,7 +133,7 @@ func (r *InfinityDiagnosticGraphResource) Create(ctx context.Context, req resour
 
 	createResponse, err := r.InfinityClient.Config().CreateDiagnosticGraph(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity diagnostic graph",
 			fmt.Sprintf("Could not create Infinity diagnostic graph: %s", err),
 		)
@@ -168,7 +172,7 @@ func (r *InfinityDiagnosticGraphResource) read(ctx context.Context, resourceID i
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("diagnostic graph with ID %d not found", resourceID)
+		return nil, notFoundErrorf("diagnostic graph with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -217,6 +221,10 @@ func (r *InfinityDiagnosticGraphResource) Read(ctx context.Context, req resource
 }
 
 func (r *InfinityDiagnosticGraphResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity diagnostic graph", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityDiagnosticGraphResourceModel{}
 	state := &InfinityDiagnosticGraphResourceModel{}
 
@@ -249,7 +257,7 @@ func (r *InfinityDiagnosticGraphResource) Update(ctx context.Context, req resour
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateDiagnosticGraph(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity diagnostic graph",
 			fmt.Sprintf("Could not update Infinity diagnostic graph: %s", err),
 		)
@@ -270,6 +278,10 @@ func (r *InfinityDiagnosticGraphResource) Update(ctx context.Context, req resour
 }
 
 func (r *InfinityDiagnosticGraphResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity diagnostic graph", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityDiagnosticGraphResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity diagnostic graph")
@@ -281,8 +293,8 @@ func (r *InfinityDiagnosticGraphResource) Delete(ctx context.Context, req resour
 
 	err := r.InfinityClient.Config().DeleteDiagnosticGraph(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity diagnostic graph",
 			fmt.Sprintf("Could not delete Infinity diagnostic graph with ID %s: %s", state.ID.ValueString(), err),
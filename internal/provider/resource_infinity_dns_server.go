@@ -101,6 +101,10 @@ func (r *InfinityDnsServerResource) Schema(ctx context.Context, req resource.Sch
 }
 
 func (r *InfinityDnsServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity DNS server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityDnsServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -119,7 +123,7 @@ func (r *InfinityDnsServerResource) Create(ctx context.Context, req resource.Cre
 
 	createResponse, err := r.InfinityClient.Config().CreateDNSServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity DNS server",
 			fmt.Sprintf("Could not create Infinity DNS server: %s", err),
 		)
@@ -158,7 +162,7 @@ func (r *InfinityDnsServerResource) read(ctx context.Context, resourceID int) (*
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("DNS server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("DNS server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -196,6 +200,10 @@ func (r *InfinityDnsServerResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *InfinityDnsServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity DNS server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityDnsServerResourceModel{}
 	state := &InfinityDnsServerResourceModel{}
 
@@ -220,7 +228,7 @@ func (r *InfinityDnsServerResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.InfinityClient.Config().UpdateDNSServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity DNS server",
 			fmt.Sprintf("Could not update Infinity DNS server with ID %d: %s", resourceID, err),
 		)
@@ -241,6 +249,10 @@ func (r *InfinityDnsServerResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *InfinityDnsServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity DNS Server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityDnsServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity DNS Server")
@@ -253,8 +265,8 @@ func (r *InfinityDnsServerResource) Delete(ctx context.Context, req resource.Del
 	resourceID := int(state.ResourceID.ValueInt32())
 	err := r.InfinityClient.Config().DeleteDNSServer(ctx, resourceID)
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity DNS Server",
 			fmt.Sprintf("Could not delete Infinity DNS Server with ID %d: %s", resourceID, err),
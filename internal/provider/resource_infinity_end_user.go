@@ -205,6 +205,10 @@ func (r *InfinityEndUserResource) Schema(ctx context.Context, req resource.Schem
 }
 
 func (r *InfinityEndUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity end user", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityEndUserResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -261,7 +265,7 @@ func (r *InfinityEndUserResource) Create(ctx context.Context, req resource.Creat
 
 	createResponse, err := r.InfinityClient.Config().CreateEndUser(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity end user",
 			fmt.Sprintf("Could not create Infinity end user: %s", err),
 		)
@@ -300,7 +304,7 @@ func (r *InfinityEndUserResource) read(ctx context.Context, resourceID int) (*In
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("end user with ID %d not found", resourceID)
+		return nil, notFoundErrorf("end user with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -372,6 +376,10 @@ func (r *InfinityEndUserResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *InfinityEndUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity end user", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityEndUserResourceModel{}
 	state := &InfinityEndUserResourceModel{}
 
@@ -432,7 +440,7 @@ func (r *InfinityEndUserResource) Update(ctx context.Context, req resource.Updat
 
 	_, err := r.InfinityClient.Config().UpdateEndUser(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity end user",
 			fmt.Sprintf("Could not update Infinity end user with ID %d: %s", resourceID, err),
 		)
@@ -453,6 +461,10 @@ func (r *InfinityEndUserResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *InfinityEndUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity end user", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityEndUserResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity end user")
@@ -464,8 +476,8 @@ func (r *InfinityEndUserResource) Delete(ctx context.Context, req resource.Delet
 
 	err := r.InfinityClient.Config().DeleteEndUser(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity end user",
 			fmt.Sprintf("Could not delete Infinity end user with ID %s: %s", state.ID.ValueString(), err),
@@ -156,6 +156,10 @@ func (r *InfinityEventSinkResource) Schema(ctx context.Context, req resource.Sch
 }
 
 func (r *InfinityEventSinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity event sink", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityEventSinkResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -187,7 +191,7 @@ func (r *InfinityEventSinkResource) Create(ctx context.Context, req resource.Cre
 
 	createResponse, err := r.InfinityClient.Config().CreateEventSink(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity event sink",
 			fmt.Sprintf("Could not create Infinity event sink: %s", err),
 		)
@@ -226,7 +230,7 @@ func (r *InfinityEventSinkResource) read(ctx context.Context, resourceID int, pa
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("event sink with ID %d not found", resourceID)
+		return nil, notFoundErrorf("event sink with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -280,6 +284,10 @@ func (r *InfinityEventSinkResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *InfinityEventSinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity event sink", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityEventSinkResourceModel{}
 	state := &InfinityEventSinkResourceModel{}
 
@@ -318,7 +326,7 @@ func (r *InfinityEventSinkResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.InfinityClient.Config().UpdateEventSink(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity event sink",
 			fmt.Sprintf("Could not update Infinity event sink with ID %d: %s", resourceID, err),
 		)
@@ -339,6 +347,10 @@ func (r *InfinityEventSinkResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *InfinityEventSinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity event sink", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityEventSinkResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity event sink")
@@ -350,8 +362,8 @@ func (r *InfinityEventSinkResource) Delete(ctx context.Context, req resource.Del
 
 	err := r.InfinityClient.Config().DeleteEventSink(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity event sink",
 			fmt.Sprintf("Could not delete Infinity event sink with ID %s: %s", state.ID.ValueString(), err),
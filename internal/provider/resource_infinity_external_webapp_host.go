@@ -89,6 +89,10 @@ func (r *InfinityExternalWebappHostResource) Schema(ctx context.Context, req res
 }
 
 func (r *InfinityExternalWebappHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity external webapp host", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityExternalWebappHostResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -102,7 +106,7 @@ func (r *InfinityExternalWebappHostResource) Create(ctx context.Context, req res
 
 	createResponse, err := r.InfinityClient.Config().CreateExternalWebappHost(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity external webapp host",
 			fmt.Sprintf("Could not create Infinity external webapp host: %s", err),
 		)
@@ -141,7 +145,7 @@ func (r *InfinityExternalWebappHostResource) read(ctx context.Context, resourceI
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("external webapp host with ID %d not found", resourceID)
+		return nil, notFoundErrorf("external webapp host with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -178,6 +182,10 @@ func (r *InfinityExternalWebappHostResource) Read(ctx context.Context, req resou
 }
 
 func (r *InfinityExternalWebappHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity external webapp host", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityExternalWebappHostResourceModel{}
 	state := &InfinityExternalWebappHostResourceModel{}
 
@@ -194,7 +202,7 @@ func (r *InfinityExternalWebappHostResource) Update(ctx context.Context, req res
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateExternalWebappHost(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity external webapp host",
 			fmt.Sprintf("Could not update Infinity external webapp host: %s", err),
 		)
@@ -215,6 +223,10 @@ func (r *InfinityExternalWebappHostResource) Update(ctx context.Context, req res
 }
 
 func (r *InfinityExternalWebappHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity external webapp host", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityExternalWebappHostResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity external webapp host")
@@ -226,8 +238,8 @@ func (r *InfinityExternalWebappHostResource) Delete(ctx context.Context, req res
 
 	err := r.InfinityClient.Config().DeleteExternalWebappHost(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity external webapp host",
 			fmt.Sprintf("Could not delete Infinity external webapp host with ID %s: %s", state.ID.ValueString(), err),
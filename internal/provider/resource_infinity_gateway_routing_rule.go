@@ -370,6 +370,10 @@ func (r *InfinityGatewayRoutingRuleResource) Schema(ctx context.Context, req res
 }
 
 func (r *InfinityGatewayRoutingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity gateway routing rule", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityGatewayRoutingRuleResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -480,7 +484,7 @@ func (r *InfinityGatewayRoutingRuleResource) Create(ctx context.Context, req res
 
 	createResponse, err := r.InfinityClient.Config().CreateGatewayRoutingRule(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity gateway routing rule",
 			fmt.Sprintf("Could not create Infinity gateway routing rule: %s", err),
 		)
@@ -519,7 +523,7 @@ func (r *InfinityGatewayRoutingRuleResource) read(ctx context.Context, resourceI
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("gateway routing rule with ID %d not found", resourceID)
+		return nil, notFoundErrorf("gateway routing rule with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -609,6 +613,10 @@ func (r *InfinityGatewayRoutingRuleResource) Read(ctx context.Context, req resou
 }
 
 func (r *InfinityGatewayRoutingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity gateway routing rule", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityGatewayRoutingRuleResourceModel{}
 	state := &InfinityGatewayRoutingRuleResourceModel{}
 
@@ -723,7 +731,7 @@ func (r *InfinityGatewayRoutingRuleResource) Update(ctx context.Context, req res
 
 	_, err := r.InfinityClient.Config().UpdateGatewayRoutingRule(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity gateway routing rule",
 			fmt.Sprintf("Could not update Infinity gateway routing rule with ID %d: %s", resourceID, err),
 		)
@@ -744,6 +752,10 @@ func (r *InfinityGatewayRoutingRuleResource) Update(ctx context.Context, req res
 }
 
 func (r *InfinityGatewayRoutingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity gateway routing rule", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityGatewayRoutingRuleResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity gateway routing rule")
@@ -755,8 +767,8 @@ func (r *InfinityGatewayRoutingRuleResource) Delete(ctx context.Context, req res
 
 	err := r.InfinityClient.Config().DeleteGatewayRoutingRule(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity gateway routing rule",
 			fmt.Sprintf("Could not delete Infinity gateway routing rule with ID %s: %s", state.ID.ValueString(), err),
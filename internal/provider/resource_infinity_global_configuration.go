@@ -950,6 +950,10 @@ func (r *InfinityGlobalConfigurationResource) buildUpdateRequest(plan *InfinityG
 }
 
 func (r *InfinityGlobalConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity global configuration", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, Create is actually Update since the resource always exists
 	plan := &InfinityGlobalConfigurationResourceModel{}
 
@@ -962,7 +966,7 @@ func (r *InfinityGlobalConfigurationResource) Create(ctx context.Context, req re
 
 	_, err := r.InfinityClient.Config().UpdateGlobalConfiguration(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity global configuration",
 			fmt.Sprintf("Could not update Infinity global configuration: %s", err),
 		)
@@ -991,7 +995,7 @@ func (r *InfinityGlobalConfigurationResource) read(ctx context.Context, awsSecre
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("global configuration not found")
+		return nil, notFoundErrorf("global configuration not found")
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -1136,6 +1140,10 @@ func (r *InfinityGlobalConfigurationResource) Read(ctx context.Context, req reso
 }
 
 func (r *InfinityGlobalConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity global configuration", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityGlobalConfigurationResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -1147,7 +1155,7 @@ func (r *InfinityGlobalConfigurationResource) Update(ctx context.Context, req re
 
 	_, err := r.InfinityClient.Config().UpdateGlobalConfiguration(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity global configuration",
 			fmt.Sprintf("Could not update Infinity global configuration: %s", err),
 		)
@@ -1168,6 +1176,10 @@ func (r *InfinityGlobalConfigurationResource) Update(ctx context.Context, req re
 }
 
 func (r *InfinityGlobalConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity global configuration", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, delete means resetting all fields to their schema defaults.
 	tflog.Info(ctx, "Deleting Infinity global configuration (resetting to defaults)")
 
@@ -1269,7 +1281,7 @@ func (r *InfinityGlobalConfigurationResource) Delete(ctx context.Context, req re
 	}
 
 	_, err := r.InfinityClient.Config().UpdateGlobalConfiguration(ctx, updateRequest)
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity global configuration",
 			fmt.Sprintf("Could not delete Infinity global configuration: %s", err),
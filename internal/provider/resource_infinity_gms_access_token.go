@@ -98,6 +98,10 @@ func (r *InfinityGMSAccessTokenResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityGMSAccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity GMS access token", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityGMSAccessTokenResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -112,7 +116,7 @@ func (r *InfinityGMSAccessTokenResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateGMSAccessToken(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity GMS access token",
 			fmt.Sprintf("Could not create Infinity GMS access token: %s", err),
 		)
@@ -151,7 +155,7 @@ func (r *InfinityGMSAccessTokenResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("GMS access token with ID %d not found", resourceID)
+		return nil, notFoundErrorf("GMS access token with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -190,6 +194,10 @@ func (r *InfinityGMSAccessTokenResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityGMSAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity GMS access token", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityGMSAccessTokenResourceModel{}
 	state := &InfinityGMSAccessTokenResourceModel{}
 
@@ -208,7 +216,7 @@ func (r *InfinityGMSAccessTokenResource) Update(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateGMSAccessToken(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity GMS access token",
 			fmt.Sprintf("Could not update Infinity GMS access token with ID %d: %s", resourceID, err),
 		)
@@ -229,6 +237,10 @@ func (r *InfinityGMSAccessTokenResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityGMSAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity GMS access token", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityGMSAccessTokenResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity GMS access token")
@@ -240,8 +252,8 @@ func (r *InfinityGMSAccessTokenResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteGMSAccessToken(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity GMS access token",
 			fmt.Sprintf("Could not delete Infinity GMS access token with ID %s: %s", state.ID.ValueString(), err),
@@ -126,6 +126,10 @@ func (r *InfinityGMSGatewayTokenResource) buildUpdateRequest(plan *InfinityGMSGa
 }
 
 func (r *InfinityGMSGatewayTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity GMS gateway token", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, Create is actually Update since the resource always exists
 	plan := &InfinityGMSGatewayTokenResourceModel{}
 
@@ -138,7 +142,7 @@ func (r *InfinityGMSGatewayTokenResource) Create(ctx context.Context, req resour
 
 	_, err := r.InfinityClient.Config().UpdateGMSGatewayToken(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity GMS gateway token",
 			fmt.Sprintf("Could not update Infinity GMS gateway token: %s", err),
 		)
@@ -167,7 +171,7 @@ func (r *InfinityGMSGatewayTokenResource) read(ctx context.Context, cert string,
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("GMS gateway token configuration not found")
+		return nil, notFoundErrorf("GMS gateway token configuration not found")
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -212,6 +216,10 @@ func (r *InfinityGMSGatewayTokenResource) Read(ctx context.Context, req resource
 }
 
 func (r *InfinityGMSGatewayTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity GMS gateway token", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityGMSGatewayTokenResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -223,7 +231,7 @@ func (r *InfinityGMSGatewayTokenResource) Update(ctx context.Context, req resour
 
 	_, err := r.InfinityClient.Config().UpdateGMSGatewayToken(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity GMS gateway token",
 			fmt.Sprintf("Could not update Infinity GMS gateway token: %s", err),
 		)
@@ -244,6 +252,10 @@ func (r *InfinityGMSGatewayTokenResource) Update(ctx context.Context, req resour
 }
 
 func (r *InfinityGMSGatewayTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity GMS gateway token", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, delete means resetting to default/minimal values
 	tflog.Info(ctx, "The Infinity SDK does not yet support deleting the GMS gateway token. It will be removed from state.")
 }
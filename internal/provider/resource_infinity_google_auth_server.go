@@ -122,6 +122,10 @@ func (r *InfinityGoogleAuthServerResource) Schema(ctx context.Context, req resou
 }
 
 func (r *InfinityGoogleAuthServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity Google auth server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityGoogleAuthServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -144,7 +148,7 @@ func (r *InfinityGoogleAuthServerResource) Create(ctx context.Context, req resou
 
 	createResponse, err := r.InfinityClient.Config().CreateGoogleAuthServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity Google auth server",
 			fmt.Sprintf("Could not create Infinity Google auth server: %s", err),
 		)
@@ -183,7 +187,7 @@ func (r *InfinityGoogleAuthServerResource) read(ctx context.Context, resourceID
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("google auth server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("google auth server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -230,6 +234,10 @@ func (r *InfinityGoogleAuthServerResource) Read(ctx context.Context, req resourc
 }
 
 func (r *InfinityGoogleAuthServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity Google auth server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityGoogleAuthServerResourceModel{}
 	state := &InfinityGoogleAuthServerResourceModel{}
 
@@ -255,7 +263,7 @@ func (r *InfinityGoogleAuthServerResource) Update(ctx context.Context, req resou
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateGoogleAuthServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity Google auth server",
 			fmt.Sprintf("Could not update Infinity Google auth server: %s", err),
 		)
@@ -276,6 +284,10 @@ func (r *InfinityGoogleAuthServerResource) Update(ctx context.Context, req resou
 }
 
 func (r *InfinityGoogleAuthServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity Google auth server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityGoogleAuthServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity Google auth server")
@@ -287,8 +299,8 @@ func (r *InfinityGoogleAuthServerResource) Delete(ctx context.Context, req resou
 
 	err := r.InfinityClient.Config().DeleteGoogleAuthServer(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity Google auth server",
 			fmt.Sprintf("Could not delete Infinity Google auth server with ID %s: %s", state.ID.ValueString(), err),
@@ -120,6 +120,10 @@ func (r *InfinityH323GatekeeperResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityH323GatekeeperResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity H.323 gatekeeper", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityH323GatekeeperResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -141,7 +145,7 @@ func (r *InfinityH323GatekeeperResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateH323Gatekeeper(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity H.323 gatekeeper",
 			fmt.Sprintf("Could not create Infinity H.323 gatekeeper: %s", err),
 		)
@@ -180,7 +184,7 @@ func (r *InfinityH323GatekeeperResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("H.323 gatekeeper with ID %d not found", resourceID)
+		return nil, notFoundErrorf("H.323 gatekeeper with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -226,6 +230,10 @@ func (r *InfinityH323GatekeeperResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityH323GatekeeperResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity H.323 gatekeeper", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityH323GatekeeperResourceModel{}
 	state := &InfinityH323GatekeeperResourceModel{}
 
@@ -250,7 +258,7 @@ func (r *InfinityH323GatekeeperResource) Update(ctx context.Context, req resourc
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateH323Gatekeeper(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity H.323 gatekeeper",
 			fmt.Sprintf("Could not update Infinity H.323 gatekeeper: %s", err),
 		)
@@ -271,6 +279,10 @@ func (r *InfinityH323GatekeeperResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityH323GatekeeperResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity H.323 gatekeeper", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityH323GatekeeperResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity H.323 gatekeeper")
@@ -282,8 +294,8 @@ func (r *InfinityH323GatekeeperResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteH323Gatekeeper(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity H.323 gatekeeper",
 			fmt.Sprintf("Could not delete Infinity H.323 gatekeeper with ID %s: %s", state.ID.ValueString(), err),
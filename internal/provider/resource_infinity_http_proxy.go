@@ -140,6 +140,10 @@ func (r *InfinityHTTPProxyResource) Schema(ctx context.Context, req resource.Sch
 }
 
 func (r *InfinityHTTPProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity HTTP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityHTTPProxyResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -167,7 +171,7 @@ func (r *InfinityHTTPProxyResource) Create(ctx context.Context, req resource.Cre
 
 	createResponse, err := r.InfinityClient.Config().CreateHTTPProxy(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity HTTP proxy",
 			fmt.Sprintf("Could not create Infinity HTTP proxy: %s", err),
 		)
@@ -206,7 +210,7 @@ func (r *InfinityHTTPProxyResource) read(ctx context.Context, resourceID int, pa
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("HTTP proxy with ID %d not found", resourceID)
+		return nil, notFoundErrorf("HTTP proxy with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -253,6 +257,10 @@ func (r *InfinityHTTPProxyResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *InfinityHTTPProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity HTTP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityHTTPProxyResourceModel{}
 	state := &InfinityHTTPProxyResourceModel{}
 
@@ -283,7 +291,7 @@ func (r *InfinityHTTPProxyResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.InfinityClient.Config().UpdateHTTPProxy(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity HTTP proxy",
 			fmt.Sprintf("Could not update Infinity HTTP proxy with ID %d: %s", resourceID, err),
 		)
@@ -304,6 +312,10 @@ func (r *InfinityHTTPProxyResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *InfinityHTTPProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity HTTP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityHTTPProxyResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity HTTP proxy")
@@ -315,8 +327,8 @@ func (r *InfinityHTTPProxyResource) Delete(ctx context.Context, req resource.Del
 
 	err := r.InfinityClient.Config().DeleteHTTPProxy(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity HTTP proxy",
 			fmt.Sprintf("Could not delete Infinity HTTP proxy with ID %s: %s", state.ID.ValueString(), err),
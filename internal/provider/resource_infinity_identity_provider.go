@@ -435,6 +435,10 @@ func (r *InfinityIdentityProviderResource) Schema(ctx context.Context, req resou
 }
 
 func (r *InfinityIdentityProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity identity provider", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityIdentityProviderResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -574,7 +578,7 @@ func (r *InfinityIdentityProviderResource) Create(ctx context.Context, req resou
 
 	createResponse, err := r.InfinityClient.Config().CreateIdentityProvider(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity identity provider",
 			fmt.Sprintf("Could not create Infinity identity provider: %s", err),
 		)
@@ -614,7 +618,7 @@ func (r *InfinityIdentityProviderResource) read(ctx context.Context, resourceID
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("identity provider with ID %d not found", resourceID)
+		return nil, notFoundErrorf("identity provider with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -702,6 +706,10 @@ func (r *InfinityIdentityProviderResource) Read(ctx context.Context, req resourc
 }
 
 func (r *InfinityIdentityProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity identity provider", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityIdentityProviderResourceModel{}
 	state := &InfinityIdentityProviderResourceModel{}
 
@@ -827,7 +835,7 @@ func (r *InfinityIdentityProviderResource) Update(ctx context.Context, req resou
 
 	_, err := r.InfinityClient.Config().UpdateIdentityProvider(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity identity provider",
 			fmt.Sprintf("Could not update Infinity identity provider with ID %d: %s", resourceID, err),
 		)
@@ -849,6 +857,10 @@ func (r *InfinityIdentityProviderResource) Update(ctx context.Context, req resou
 }
 
 func (r *InfinityIdentityProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity identity provider", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityIdentityProviderResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity identity provider")
@@ -860,8 +872,8 @@ func (r *InfinityIdentityProviderResource) Delete(ctx context.Context, req resou
 
 	err := r.InfinityClient.Config().DeleteIdentityProvider(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity identity provider",
 			fmt.Sprintf("Could not delete Infinity identity provider with ID %s: %s", state.ID.ValueString(), err),
@@ -100,6 +100,10 @@ func (r *InfinityIdentityProviderAttributeResource) Schema(ctx context.Context,
 }
 
 func (r *InfinityIdentityProviderAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity identity provider attribute", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityIdentityProviderAttributeResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -114,7 +118,7 @@ func (r *InfinityIdentityProviderAttributeResource) Create(ctx context.Context,
 
 	createResponse, err := r.InfinityClient.Config().CreateIdentityProviderAttribute(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity identity provider attribute",
 			fmt.Sprintf("Could not create Infinity identity provider attribute: %s", err),
 		)
@@ -153,7 +157,7 @@ func (r *InfinityIdentityProviderAttributeResource) read(ctx context.Context, re
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("identity provider attribute with ID %d not found", resourceID)
+		return nil, notFoundErrorf("identity provider attribute with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -191,6 +195,10 @@ func (r *InfinityIdentityProviderAttributeResource) Read(ctx context.Context, re
 }
 
 func (r *InfinityIdentityProviderAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity identity provider attribute", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityIdentityProviderAttributeResourceModel{}
 	state := &InfinityIdentityProviderAttributeResourceModel{}
 
@@ -208,7 +216,7 @@ func (r *InfinityIdentityProviderAttributeResource) Update(ctx context.Context,
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateIdentityProviderAttribute(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity identity provider attribute",
 			fmt.Sprintf("Could not update Infinity identity provider attribute: %s", err),
 		)
@@ -229,6 +237,10 @@ func (r *InfinityIdentityProviderAttributeResource) Update(ctx context.Context,
 }
 
 func (r *InfinityIdentityProviderAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity identity provider attribute", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityIdentityProviderAttributeResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity identity provider attribute")
@@ -240,8 +252,8 @@ func (r *InfinityIdentityProviderAttributeResource) Delete(ctx context.Context,
 
 	err := r.InfinityClient.Config().DeleteIdentityProviderAttribute(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity identity provider attribute",
 			fmt.Sprintf("Could not delete Infinity identity provider attribute with ID %s: %s", state.ID.ValueString(), err),
@@ -107,6 +107,10 @@ func (r *InfinityIdentityProviderGroupResource) Schema(ctx context.Context, req
 }
 
 func (r *InfinityIdentityProviderGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity identity provider group", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityIdentityProviderGroupResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -131,7 +135,7 @@ func (r *InfinityIdentityProviderGroupResource) Create(ctx context.Context, req
 
 	createResponse, err := r.InfinityClient.Config().CreateIdentityProviderGroup(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity identity provider group",
 			fmt.Sprintf("Could not create Infinity identity provider group: %s", err),
 		)
@@ -170,7 +174,7 @@ func (r *InfinityIdentityProviderGroupResource) read(ctx context.Context, resour
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("identity provider group with ID %d not found", resourceID)
+		return nil, notFoundErrorf("identity provider group with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -219,6 +223,10 @@ func (r *InfinityIdentityProviderGroupResource) Read(ctx context.Context, req re
 }
 
 func (r *InfinityIdentityProviderGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity identity provider group", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityIdentityProviderGroupResourceModel{}
 	state := &InfinityIdentityProviderGroupResourceModel{}
 
@@ -246,7 +254,7 @@ func (r *InfinityIdentityProviderGroupResource) Update(ctx context.Context, req
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateIdentityProviderGroup(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity identity provider group",
 			fmt.Sprintf("Could not update Infinity identity provider group: %s", err),
 		)
@@ -267,6 +275,10 @@ func (r *InfinityIdentityProviderGroupResource) Update(ctx context.Context, req
 }
 
 func (r *InfinityIdentityProviderGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity identity provider group", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityIdentityProviderGroupResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity identity provider group")
@@ -278,8 +290,8 @@ func (r *InfinityIdentityProviderGroupResource) Delete(ctx context.Context, req
 
 	err := r.InfinityClient.Config().DeleteIdentityProviderGroup(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity identity provider group",
 			fmt.Sprintf("Could not delete Infinity identity provider group with ID %s: %s", state.ID.ValueString(), err),
@@ -100,6 +100,10 @@ func (r *InfinityIvrThemeResource) Schema(ctx context.Context, req resource.Sche
 }
 
 func (r *InfinityIvrThemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity IVR theme", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityIvrThemeResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -130,7 +134,7 @@ func (r *InfinityIvrThemeResource) Create(ctx context.Context, req resource.Crea
 
 	createResponse, err := r.InfinityClient.Config().CreateIVRTheme(ctx, createRequest, filename, packageFile)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity IVR theme",
 			fmt.Sprintf("Could not create Infinity IVR theme: %s", err),
 		)
@@ -171,7 +175,7 @@ func (r *InfinityIvrThemeResource) read(ctx context.Context, resourceID int) (*I
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("IVR theme with ID %d not found", resourceID)
+		return nil, notFoundErrorf("IVR theme with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -217,6 +221,10 @@ func (r *InfinityIvrThemeResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *InfinityIvrThemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity IVR theme", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityIvrThemeResourceModel{}
 	state := &InfinityIvrThemeResourceModel{}
 
@@ -251,7 +259,7 @@ func (r *InfinityIvrThemeResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.InfinityClient.Config().UpdateIVRTheme(ctx, resourceID, updateRequest, filename, packageFile)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity IVR theme",
 			fmt.Sprintf("Could not update Infinity IVR theme with ID %d: %s", resourceID, err),
 		)
@@ -275,6 +283,10 @@ func (r *InfinityIvrThemeResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *InfinityIvrThemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity IVR theme", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityIvrThemeResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity IVR theme")
@@ -286,8 +298,8 @@ func (r *InfinityIvrThemeResource) Delete(ctx context.Context, req resource.Dele
 
 	err := r.InfinityClient.Config().DeleteIVRTheme(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity IVR theme",
 			fmt.Sprintf("Could not delete Infinity IVR theme with ID %s: %s", state.ID.ValueString(), err),
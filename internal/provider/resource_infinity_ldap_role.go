@@ -104,6 +104,10 @@ func (r *InfinityLdapRoleResource) Schema(ctx context.Context, req resource.Sche
 }
 
 func (r *InfinityLdapRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity LDAP role", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLdapRoleResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -128,7 +132,7 @@ func (r *InfinityLdapRoleResource) Create(ctx context.Context, req resource.Crea
 
 	createResponse, err := r.InfinityClient.Config().CreateLdapRole(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity LDAP role",
 			fmt.Sprintf("Could not create Infinity LDAP role: %s", err),
 		)
@@ -167,7 +171,7 @@ func (r *InfinityLdapRoleResource) read(ctx context.Context, resourceID int) (*I
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("LDAP role with ID %d not found", resourceID)
+		return nil, notFoundErrorf("LDAP role with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -216,6 +220,10 @@ func (r *InfinityLdapRoleResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *InfinityLdapRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity LDAP role", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLdapRoleResourceModel{}
 	state := &InfinityLdapRoleResourceModel{}
 
@@ -243,7 +251,7 @@ func (r *InfinityLdapRoleResource) Update(ctx context.Context, req resource.Upda
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateLdapRole(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity LDAP role",
 			fmt.Sprintf("Could not update Infinity LDAP role: %s", err),
 		)
@@ -264,6 +272,10 @@ func (r *InfinityLdapRoleResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *InfinityLdapRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity LDAP role", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityLdapRoleResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity LDAP role")
@@ -275,8 +287,8 @@ func (r *InfinityLdapRoleResource) Delete(ctx context.Context, req resource.Dele
 
 	err := r.InfinityClient.Config().DeleteLdapRole(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity LDAP role",
 			fmt.Sprintf("Could not delete Infinity LDAP role with ID %s: %s", state.ID.ValueString(), err),
@@ -117,6 +117,10 @@ func (r *InfinityLdapSyncFieldResource) Schema(ctx context.Context, req resource
 }
 
 func (r *InfinityLdapSyncFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity LDAP sync field", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLdapSyncFieldResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -133,7 +137,7 @@ func (r *InfinityLdapSyncFieldResource) Create(ctx context.Context, req resource
 
 	createResponse, err := r.InfinityClient.Config().CreateLdapSyncField(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity LDAP sync field",
 			fmt.Sprintf("Could not create Infinity LDAP sync field: %s", err),
 		)
@@ -172,7 +176,7 @@ func (r *InfinityLdapSyncFieldResource) read(ctx context.Context, resourceID int
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("LDAP sync field with ID %d not found", resourceID)
+		return nil, notFoundErrorf("LDAP sync field with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -212,6 +216,10 @@ func (r *InfinityLdapSyncFieldResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *InfinityLdapSyncFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity LDAP sync field", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLdapSyncFieldResourceModel{}
 	state := &InfinityLdapSyncFieldResourceModel{}
 
@@ -236,7 +244,7 @@ func (r *InfinityLdapSyncFieldResource) Update(ctx context.Context, req resource
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateLdapSyncField(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity LDAP sync field",
 			fmt.Sprintf("Could not update Infinity LDAP sync field: %s", err),
 		)
@@ -257,6 +265,10 @@ func (r *InfinityLdapSyncFieldResource) Update(ctx context.Context, req resource
 }
 
 func (r *InfinityLdapSyncFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity LDAP sync field", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityLdapSyncFieldResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity LDAP sync field")
@@ -268,8 +280,8 @@ func (r *InfinityLdapSyncFieldResource) Delete(ctx context.Context, req resource
 
 	err := r.InfinityClient.Config().DeleteLdapSyncField(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity LDAP sync field",
 			fmt.Sprintf("Could not delete Infinity LDAP sync field with ID %s: %s", state.ID.ValueString(), err),
@@ -142,6 +142,10 @@ func (r *InfinityLdapSyncSourceResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityLdapSyncSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity LDAP sync source", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLdapSyncSourceResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -170,7 +174,7 @@ func (r *InfinityLdapSyncSourceResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateLdapSyncSource(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity LDAP sync source",
 			fmt.Sprintf("Could not create Infinity LDAP sync source: %s", err),
 		)
@@ -209,7 +213,7 @@ func (r *InfinityLdapSyncSourceResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("LDAP sync source with ID %d not found", resourceID)
+		return nil, notFoundErrorf("LDAP sync source with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -255,6 +259,10 @@ func (r *InfinityLdapSyncSourceResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityLdapSyncSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity LDAP sync source", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLdapSyncSourceResourceModel{}
 	state := &InfinityLdapSyncSourceResourceModel{}
 
@@ -288,7 +296,7 @@ func (r *InfinityLdapSyncSourceResource) Update(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateLdapSyncSource(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity LDAP sync source",
 			fmt.Sprintf("Could not update Infinity LDAP sync source with ID %d: %s", resourceID, err),
 		)
@@ -309,6 +317,10 @@ func (r *InfinityLdapSyncSourceResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityLdapSyncSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity LDAP sync source", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityLdapSyncSourceResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity LDAP sync source")
@@ -320,8 +332,8 @@ func (r *InfinityLdapSyncSourceResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteLdapSyncSource(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity LDAP sync source",
 			fmt.Sprintf("Could not delete Infinity LDAP sync source with ID %s: %s", state.ID.ValueString(), err),
@@ -177,6 +177,10 @@ func (r *InfinityLicenceResource) Schema(ctx context.Context, req resource.Schem
 }
 
 func (r *InfinityLicenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity licence", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLicenceResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -191,7 +195,7 @@ func (r *InfinityLicenceResource) Create(ctx context.Context, req resource.Creat
 
 	_, err := r.InfinityClient.Config().CreateLicence(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity licence",
 			fmt.Sprintf("Could not create Infinity licence: %s", err),
 		)
@@ -245,7 +249,7 @@ func (r *InfinityLicenceResource) read(ctx context.Context, fulfillmentID, entit
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("licence with fulfillment ID %s not found", fulfillmentID)
+		return nil, notFoundErrorf("licence with fulfillment ID %s not found", fulfillmentID)
 	}
 
 	if entitlementID != "" {
@@ -304,6 +308,10 @@ func (r *InfinityLicenceResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *InfinityLicenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity licence", &resp.Diagnostics) {
+		return
+	}
+
 	// Licences cannot be updated - they are immutable once activated
 	// Only deactivation (delete) and re-activation (create) are supported
 	resp.Diagnostics.AddError(
@@ -313,6 +321,10 @@ func (r *InfinityLicenceResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *InfinityLicenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity licence", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityLicenceResourceModel{}
 
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
@@ -324,8 +336,8 @@ func (r *InfinityLicenceResource) Delete(ctx context.Context, req resource.Delet
 	tflog.Info(ctx, "Deleting Infinity licence", map[string]interface{}{"fulfillment_id": fulfillmentID})
 	err := r.InfinityClient.Config().DeleteLicence(ctx, fulfillmentID)
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity licence",
 			fmt.Sprintf("Could not delete Infinity licence with fulfillment ID %s: %s", fulfillmentID, err),
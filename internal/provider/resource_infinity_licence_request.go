@@ -107,6 +107,10 @@ func (r *InfinityLicenceRequestResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityLicenceRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity licence request", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLicenceRequestResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -121,7 +125,7 @@ func (r *InfinityLicenceRequestResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateLicenceRequest(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity licence request",
 			fmt.Sprintf("Could not create Infinity licence request: %s", err),
 		)
@@ -164,7 +168,7 @@ func (r *InfinityLicenceRequestResource) read(ctx context.Context, sequenceNumbe
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("licence request with sequence number %s not found", sequenceNumber)
+		return nil, notFoundErrorf("licence request with sequence number %s not found", sequenceNumber)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -210,6 +214,10 @@ func (r *InfinityLicenceRequestResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityLicenceRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity licence request", &resp.Diagnostics) {
+		return
+	}
+
 	resp.Diagnostics.AddError(
 		"Update Not Supported",
 		"Licence request resources cannot be updated. Licence requests are immutable once created.",
@@ -217,6 +225,10 @@ func (r *InfinityLicenceRequestResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityLicenceRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity licence request", &resp.Diagnostics) {
+		return
+	}
+
 	resp.State.RemoveResource(ctx)
 }
 
@@ -100,6 +100,10 @@ func (r *InfinityLogLevelResource) Schema(ctx context.Context, req resource.Sche
 }
 
 func (r *InfinityLogLevelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity log level", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLogLevelResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -114,7 +118,7 @@ func (r *InfinityLogLevelResource) Create(ctx context.Context, req resource.Crea
 
 	createResponse, err := r.InfinityClient.Config().CreateLogLevel(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity log level",
 			fmt.Sprintf("Could not create Infinity log level: %s", err),
 		)
@@ -153,7 +157,7 @@ func (r *InfinityLogLevelResource) read(ctx context.Context, resourceID int) (*I
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("log level with ID %d not found", resourceID)
+		return nil, notFoundErrorf("log level with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -191,6 +195,10 @@ func (r *InfinityLogLevelResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *InfinityLogLevelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity log level", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityLogLevelResourceModel{}
 	state := &InfinityLogLevelResourceModel{}
 
@@ -209,7 +217,7 @@ func (r *InfinityLogLevelResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.InfinityClient.Config().UpdateLogLevel(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity log level",
 			fmt.Sprintf("Could not update Infinity log level with ID %d: %s", resourceID, err),
 		)
@@ -230,6 +238,10 @@ func (r *InfinityLogLevelResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *InfinityLogLevelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity log level", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityLogLevelResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity log level")
@@ -241,8 +253,8 @@ func (r *InfinityLogLevelResource) Delete(ctx context.Context, req resource.Dele
 
 	err := r.InfinityClient.Config().DeleteLogLevel(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity log level",
 			fmt.Sprintf("Could not delete Infinity log level with ID %s: %s", state.ID.ValueString(), err),
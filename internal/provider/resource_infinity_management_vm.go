@@ -407,6 +407,10 @@ func (r *InfinityManagementVMResource) buildUpdateRequest(plan *InfinityManageme
 }
 
 func (r *InfinityManagementVMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity Management VM", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, Create is actually Update since the resource always exists
 	plan := &InfinityManagementVMResourceModel{}
 
@@ -443,7 +447,7 @@ func (r *InfinityManagementVMResource) Create(ctx context.Context, req resource.
 
 	_, err := r.InfinityClient.Config().UpdateManagementVM(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity Management VM",
 			fmt.Sprintf("Could not update Infinity Management VM: %s", err),
 		)
@@ -472,7 +476,7 @@ func (r *InfinityManagementVMResource) read(ctx context.Context, resourceID int,
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("management VM with ID %d not found", resourceID)
+		return nil, notFoundErrorf("management VM with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -635,6 +639,10 @@ func (r *InfinityManagementVMResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *InfinityManagementVMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity Management VM", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityManagementVMResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -669,7 +677,7 @@ func (r *InfinityManagementVMResource) Update(ctx context.Context, req resource.
 	updateRequest.SSHAuthorizedKeys = sshAuthorizedKeys
 	_, err := r.InfinityClient.Config().UpdateManagementVM(ctx, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity Management VM",
 			fmt.Sprintf("Could not update Infinity Management VM: %s", err),
 		)
@@ -690,6 +698,10 @@ func (r *InfinityManagementVMResource) Update(ctx context.Context, req resource.
 }
 
 func (r *InfinityManagementVMResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity management VM configuration", &resp.Diagnostics) {
+		return
+	}
+
 	tflog.Info(ctx, "Resetting Infinity management VM to defaults")
 
 	updateRequest := &config.ManagementVMUpdateRequest{
@@ -715,7 +727,7 @@ func (r *InfinityManagementVMResource) Delete(ctx context.Context, req resource.
 	}
 
 	_, err := r.InfinityClient.Config().UpdateManagementVM(ctx, updateRequest)
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Resetting Infinity management VM configuration",
 			fmt.Sprintf("Could not reset Infinity management VM configuration: %s", err),
@@ -134,6 +134,10 @@ func (r *InfinityMediaLibraryEntryResource) Schema(ctx context.Context, req reso
 }
 
 func (r *InfinityMediaLibraryEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity media library entry", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMediaLibraryEntryResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -163,7 +167,7 @@ func (r *InfinityMediaLibraryEntryResource) Create(ctx context.Context, req reso
 
 	createResponse, err := r.InfinityClient.Config().CreateMediaLibraryEntry(ctx, createRequest, filename, mediaFile)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity media library entry",
 			fmt.Sprintf("Could not create Infinity media library entry: %s", err),
 		)
@@ -204,7 +208,7 @@ func (r *InfinityMediaLibraryEntryResource) read(ctx context.Context, resourceID
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("media library entry with ID %d not found", resourceID)
+		return nil, notFoundErrorf("media library entry with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -255,6 +259,10 @@ func (r *InfinityMediaLibraryEntryResource) Read(ctx context.Context, req resour
 }
 
 func (r *InfinityMediaLibraryEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity media library entry", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMediaLibraryEntryResourceModel{}
 	state := &InfinityMediaLibraryEntryResourceModel{}
 
@@ -288,7 +296,7 @@ func (r *InfinityMediaLibraryEntryResource) Update(ctx context.Context, req reso
 
 	_, err = r.InfinityClient.Config().UpdateMediaLibraryEntry(ctx, resourceID, updateRequest, filename, mediaFile)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity media library entry",
 			fmt.Sprintf("Could not update Infinity media library entry with ID %d: %s", resourceID, err),
 		)
@@ -312,6 +320,10 @@ func (r *InfinityMediaLibraryEntryResource) Update(ctx context.Context, req reso
 }
 
 func (r *InfinityMediaLibraryEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity media library entry", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMediaLibraryEntryResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity media library entry")
@@ -323,8 +335,8 @@ func (r *InfinityMediaLibraryEntryResource) Delete(ctx context.Context, req reso
 
 	err := r.InfinityClient.Config().DeleteMediaLibraryEntry(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity media library entry",
 			fmt.Sprintf("Could not delete Infinity media library entry with ID %s: %s", state.ID.ValueString(), err),
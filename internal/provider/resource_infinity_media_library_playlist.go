@@ -122,6 +122,10 @@ func (r *InfinityMediaLibraryPlaylistResource) Schema(ctx context.Context, req r
 }
 
 func (r *InfinityMediaLibraryPlaylistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity media library playlist", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMediaLibraryPlaylistResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -148,7 +152,7 @@ func (r *InfinityMediaLibraryPlaylistResource) Create(ctx context.Context, req r
 
 	createResponse, err := r.InfinityClient.Config().CreateMediaLibraryPlaylist(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity media library playlist",
 			fmt.Sprintf("Could not create Infinity media library playlist: %s", err),
 		)
@@ -187,7 +191,7 @@ func (r *InfinityMediaLibraryPlaylistResource) read(ctx context.Context, resourc
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("media library playlist with ID %d not found", resourceID)
+		return nil, notFoundErrorf("media library playlist with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -242,6 +246,10 @@ func (r *InfinityMediaLibraryPlaylistResource) Read(ctx context.Context, req res
 }
 
 func (r *InfinityMediaLibraryPlaylistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity media library playlist", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMediaLibraryPlaylistResourceModel{}
 	state := &InfinityMediaLibraryPlaylistResourceModel{}
 
@@ -281,7 +289,7 @@ func (r *InfinityMediaLibraryPlaylistResource) Update(ctx context.Context, req r
 
 	_, err := r.InfinityClient.Config().UpdateMediaLibraryPlaylist(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity media library playlist",
 			fmt.Sprintf("Could not update Infinity media library playlist with ID %d: %s", resourceID, err),
 		)
@@ -302,6 +310,10 @@ func (r *InfinityMediaLibraryPlaylistResource) Update(ctx context.Context, req r
 }
 
 func (r *InfinityMediaLibraryPlaylistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity media library playlist", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMediaLibraryPlaylistResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity media library playlist")
@@ -313,8 +325,8 @@ func (r *InfinityMediaLibraryPlaylistResource) Delete(ctx context.Context, req r
 
 	err := r.InfinityClient.Config().DeleteMediaLibraryPlaylist(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity media library playlist",
 			fmt.Sprintf("Could not delete Infinity media library playlist with ID %s: %s", state.ID.ValueString(), err),
@@ -124,6 +124,10 @@ func (r *InfinityMediaLibraryPlaylistEntryResource) Schema(ctx context.Context,
 }
 
 func (r *InfinityMediaLibraryPlaylistEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity media library playlist entry", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMediaLibraryPlaylistEntryResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -152,7 +156,7 @@ func (r *InfinityMediaLibraryPlaylistEntryResource) Create(ctx context.Context,
 
 	createResponse, err := r.InfinityClient.Config().CreateMediaLibraryPlaylistEntry(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity media library playlist entry",
 			fmt.Sprintf("Could not create Infinity media library playlist entry: %s", err),
 		)
@@ -191,7 +195,7 @@ func (r *InfinityMediaLibraryPlaylistEntryResource) read(ctx context.Context, re
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("media library playlist entry with ID %d not found", resourceID)
+		return nil, notFoundErrorf("media library playlist entry with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -245,6 +249,10 @@ func (r *InfinityMediaLibraryPlaylistEntryResource) Read(ctx context.Context, re
 }
 
 func (r *InfinityMediaLibraryPlaylistEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity media library playlist entry", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMediaLibraryPlaylistEntryResourceModel{}
 	state := &InfinityMediaLibraryPlaylistEntryResourceModel{}
 
@@ -287,7 +295,7 @@ func (r *InfinityMediaLibraryPlaylistEntryResource) Update(ctx context.Context,
 
 	_, err := r.InfinityClient.Config().UpdateMediaLibraryPlaylistEntry(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity media library playlist entry",
 			fmt.Sprintf("Could not update Infinity media library playlist entry with ID %d: %s", resourceID, err),
 		)
@@ -308,6 +316,10 @@ func (r *InfinityMediaLibraryPlaylistEntryResource) Update(ctx context.Context,
 }
 
 func (r *InfinityMediaLibraryPlaylistEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity media library playlist entry", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMediaLibraryPlaylistEntryResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity media library playlist entry")
@@ -319,8 +331,8 @@ func (r *InfinityMediaLibraryPlaylistEntryResource) Delete(ctx context.Context,
 
 	err := r.InfinityClient.Config().DeleteMediaLibraryPlaylistEntry(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity media library playlist entry",
 			fmt.Sprintf("Could not delete Infinity media library playlist entry with ID %s: %s", state.ID.ValueString(), err),
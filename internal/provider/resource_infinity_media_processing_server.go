@@ -99,6 +99,10 @@ func (r *InfinityMediaProcessingServerResource) Schema(ctx context.Context, req
 }
 
 func (r *InfinityMediaProcessingServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity media processing server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMediaProcessingServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -112,7 +116,7 @@ func (r *InfinityMediaProcessingServerResource) Create(ctx context.Context, req
 
 	createResponse, err := r.InfinityClient.Config().CreateMediaProcessingServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity media processing server",
 			fmt.Sprintf("Could not create Infinity media processing server: %s", err),
 		)
@@ -151,7 +155,7 @@ func (r *InfinityMediaProcessingServerResource) read(ctx context.Context, resour
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("media processing server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("media processing server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -190,6 +194,10 @@ func (r *InfinityMediaProcessingServerResource) Read(ctx context.Context, req re
 }
 
 func (r *InfinityMediaProcessingServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity media processing server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMediaProcessingServerResourceModel{}
 	state := &InfinityMediaProcessingServerResourceModel{}
 
@@ -206,7 +214,7 @@ func (r *InfinityMediaProcessingServerResource) Update(ctx context.Context, req
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMediaProcessingServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity media processing server",
 			fmt.Sprintf("Could not update Infinity media processing server: %s", err),
 		)
@@ -227,6 +235,10 @@ func (r *InfinityMediaProcessingServerResource) Update(ctx context.Context, req
 }
 
 func (r *InfinityMediaProcessingServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity media processing server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMediaProcessingServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity media processing server")
@@ -238,8 +250,8 @@ func (r *InfinityMediaProcessingServerResource) Delete(ctx context.Context, req
 
 	err := r.InfinityClient.Config().DeleteMediaProcessingServer(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity media processing server",
 			fmt.Sprintf("Could not delete Infinity media processing server with ID %s: %s", state.ID.ValueString(), err),
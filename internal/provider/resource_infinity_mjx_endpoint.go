@@ -210,6 +210,10 @@ func (r *InfinityMjxEndpointResource) Schema(ctx context.Context, req resource.S
 }
 
 func (r *InfinityMjxEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MJX endpoint", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxEndpointResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -270,7 +274,7 @@ func (r *InfinityMjxEndpointResource) Create(ctx context.Context, req resource.C
 
 	createResponse, err := r.InfinityClient.Config().CreateMjxEndpoint(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MJX endpoint",
 			fmt.Sprintf("Could not create Infinity MJX endpoint: %s", err),
 		)
@@ -314,7 +318,7 @@ func (r *InfinityMjxEndpointResource) read(ctx context.Context, resourceID int)
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MJX endpoint with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MJX endpoint with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -410,6 +414,10 @@ func (r *InfinityMjxEndpointResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *InfinityMjxEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MJX endpoint", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxEndpointResourceModel{}
 	state := &InfinityMjxEndpointResourceModel{}
 
@@ -474,7 +482,7 @@ func (r *InfinityMjxEndpointResource) Update(ctx context.Context, req resource.U
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMjxEndpoint(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MJX endpoint",
 			fmt.Sprintf("Could not update Infinity MJX endpoint: %s", err),
 		)
@@ -499,6 +507,10 @@ func (r *InfinityMjxEndpointResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *InfinityMjxEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MJX endpoint", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMjxEndpointResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MJX endpoint")
@@ -510,8 +522,8 @@ func (r *InfinityMjxEndpointResource) Delete(ctx context.Context, req resource.D
 
 	err := r.InfinityClient.Config().DeleteMjxEndpoint(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MJX endpoint",
 			fmt.Sprintf("Could not delete Infinity MJX endpoint with ID %s: %s", state.ID.ValueString(), err),
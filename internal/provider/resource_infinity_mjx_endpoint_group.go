@@ -124,6 +124,10 @@ func (r *InfinityMjxEndpointGroupResource) Schema(ctx context.Context, req resou
 }
 
 func (r *InfinityMjxEndpointGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MJX endpoint group", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxEndpointGroupResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -146,7 +150,7 @@ func (r *InfinityMjxEndpointGroupResource) Create(ctx context.Context, req resou
 
 	createResponse, err := r.InfinityClient.Config().CreateMjxEndpointGroup(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MJX endpoint group",
 			fmt.Sprintf("Could not create Infinity MJX endpoint group: %s", err),
 		)
@@ -185,7 +189,7 @@ func (r *InfinityMjxEndpointGroupResource) read(ctx context.Context, resourceID
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MJX endpoint group with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MJX endpoint group with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -249,6 +253,10 @@ func (r *InfinityMjxEndpointGroupResource) Read(ctx context.Context, req resourc
 }
 
 func (r *InfinityMjxEndpointGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MJX endpoint group", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxEndpointGroupResourceModel{}
 	state := &InfinityMjxEndpointGroupResourceModel{}
 
@@ -274,7 +282,7 @@ func (r *InfinityMjxEndpointGroupResource) Update(ctx context.Context, req resou
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMjxEndpointGroup(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MJX endpoint group",
 			fmt.Sprintf("Could not update Infinity MJX endpoint group: %s", err),
 		)
@@ -294,6 +302,10 @@ func (r *InfinityMjxEndpointGroupResource) Update(ctx context.Context, req resou
 }
 
 func (r *InfinityMjxEndpointGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MJX endpoint group", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMjxEndpointGroupResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MJX endpoint group")
@@ -305,7 +317,7 @@ func (r *InfinityMjxEndpointGroupResource) Delete(ctx context.Context, req resou
 
 	err := r.InfinityClient.Config().DeleteMjxEndpointGroup(ctx, int(state.ResourceID.ValueInt32()))
 
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MJX endpoint group",
 			fmt.Sprintf("Could not delete Infinity MJX endpoint group with ID %s: %s", state.ID.ValueString(), err),
@@ -113,6 +113,10 @@ func (r *InfinityMjxExchangeAutodiscoverURLResource) Schema(ctx context.Context,
 }
 
 func (r *InfinityMjxExchangeAutodiscoverURLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MJX Exchange Autodiscover URL", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxExchangeAutodiscoverURLResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -130,7 +134,7 @@ func (r *InfinityMjxExchangeAutodiscoverURLResource) Create(ctx context.Context,
 
 	createResponse, err := r.InfinityClient.Config().CreateMjxExchangeAutodiscoverURL(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MJX Exchange Autodiscover URL",
 			fmt.Sprintf("Could not create Infinity MJX Exchange Autodiscover URL: %s", err),
 		)
@@ -169,7 +173,7 @@ func (r *InfinityMjxExchangeAutodiscoverURLResource) read(ctx context.Context, r
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MJX Exchange Autodiscover URL with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MJX Exchange Autodiscover URL with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -213,6 +217,10 @@ func (r *InfinityMjxExchangeAutodiscoverURLResource) Read(ctx context.Context, r
 }
 
 func (r *InfinityMjxExchangeAutodiscoverURLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MJX Exchange Autodiscover URL", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxExchangeAutodiscoverURLResourceModel{}
 	state := &InfinityMjxExchangeAutodiscoverURLResourceModel{}
 
@@ -233,7 +241,7 @@ func (r *InfinityMjxExchangeAutodiscoverURLResource) Update(ctx context.Context,
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMjxExchangeAutodiscoverURL(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MJX Exchange Autodiscover URL",
 			fmt.Sprintf("Could not update Infinity MJX Exchange Autodiscover URL: %s", err),
 		)
@@ -253,6 +261,10 @@ func (r *InfinityMjxExchangeAutodiscoverURLResource) Update(ctx context.Context,
 }
 
 func (r *InfinityMjxExchangeAutodiscoverURLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MJX Exchange Autodiscover URL", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMjxExchangeAutodiscoverURLResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MJX Exchange Autodiscover URL")
@@ -264,7 +276,7 @@ func (r *InfinityMjxExchangeAutodiscoverURLResource) Delete(ctx context.Context,
 
 	err := r.InfinityClient.Config().DeleteMjxExchangeAutodiscoverURL(ctx, int(state.ResourceID.ValueInt32()))
 
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MJX Exchange Autodiscover URL",
 			fmt.Sprintf("Could not delete Infinity MJX Exchange Autodiscover URL with ID %s: %s", state.ID.ValueString(), err),
@@ -276,6 +276,10 @@ func (r *InfinityMjxExchangeDeploymentResource) Schema(ctx context.Context, req
 }
 
 func (r *InfinityMjxExchangeDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MJX Exchange deployment", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxExchangeDeploymentResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -316,7 +320,7 @@ func (r *InfinityMjxExchangeDeploymentResource) Create(ctx context.Context, req
 
 	createResponse, err := r.InfinityClient.Config().CreateMjxExchangeDeployment(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MJX Exchange deployment",
 			fmt.Sprintf("Could not create Infinity MJX Exchange deployment: %s", err),
 		)
@@ -358,7 +362,7 @@ func (r *InfinityMjxExchangeDeploymentResource) read(ctx context.Context, resour
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MJX Exchange deployment with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MJX Exchange deployment with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -458,6 +462,10 @@ func (r *InfinityMjxExchangeDeploymentResource) Read(ctx context.Context, req re
 }
 
 func (r *InfinityMjxExchangeDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MJX Exchange deployment", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxExchangeDeploymentResourceModel{}
 	state := &InfinityMjxExchangeDeploymentResourceModel{}
 
@@ -506,7 +514,7 @@ func (r *InfinityMjxExchangeDeploymentResource) Update(ctx context.Context, req
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMjxExchangeDeployment(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MJX Exchange deployment",
 			fmt.Sprintf("Could not update Infinity MJX Exchange deployment: %s", err),
 		)
@@ -529,6 +537,10 @@ func (r *InfinityMjxExchangeDeploymentResource) Update(ctx context.Context, req
 }
 
 func (r *InfinityMjxExchangeDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MJX Exchange deployment", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMjxExchangeDeploymentResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MJX Exchange deployment")
@@ -540,7 +552,7 @@ func (r *InfinityMjxExchangeDeploymentResource) Delete(ctx context.Context, req
 
 	err := r.InfinityClient.Config().DeleteMjxExchangeDeployment(ctx, int(state.ResourceID.ValueInt32()))
 
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MJX Exchange deployment",
 			fmt.Sprintf("Could not delete Infinity MJX Exchange deployment with ID %s: %s", state.ID.ValueString(), err),
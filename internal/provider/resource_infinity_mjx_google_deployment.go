@@ -200,6 +200,10 @@ func (r *InfinityMjxGoogleDeploymentResource) Schema(ctx context.Context, req re
 }
 
 func (r *InfinityMjxGoogleDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MJX Google deployment", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxGoogleDeploymentResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -231,7 +235,7 @@ func (r *InfinityMjxGoogleDeploymentResource) Create(ctx context.Context, req re
 
 	createResponse, err := r.InfinityClient.Config().CreateMjxGoogleDeployment(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MJX Google deployment",
 			fmt.Sprintf("Could not create Infinity MJX Google deployment: %s", err),
 		)
@@ -274,7 +278,7 @@ func (r *InfinityMjxGoogleDeploymentResource) read(ctx context.Context, resource
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MJX Google deployment with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MJX Google deployment with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -347,6 +351,10 @@ func (r *InfinityMjxGoogleDeploymentResource) Read(ctx context.Context, req reso
 }
 
 func (r *InfinityMjxGoogleDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MJX Google deployment", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxGoogleDeploymentResourceModel{}
 	state := &InfinityMjxGoogleDeploymentResourceModel{}
 
@@ -383,7 +391,7 @@ func (r *InfinityMjxGoogleDeploymentResource) Update(ctx context.Context, req re
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMjxGoogleDeployment(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MJX Google deployment",
 			fmt.Sprintf("Could not update Infinity MJX Google deployment: %s", err),
 		)
@@ -407,6 +415,10 @@ func (r *InfinityMjxGoogleDeploymentResource) Update(ctx context.Context, req re
 }
 
 func (r *InfinityMjxGoogleDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MJX Google deployment", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMjxGoogleDeploymentResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MJX Google deployment")
@@ -418,7 +430,7 @@ func (r *InfinityMjxGoogleDeploymentResource) Delete(ctx context.Context, req re
 
 	err := r.InfinityClient.Config().DeleteMjxGoogleDeployment(ctx, int(state.ResourceID.ValueInt32()))
 
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MJX Google deployment",
 			fmt.Sprintf("Could not delete Infinity MJX Google deployment with ID %s: %s", state.ID.ValueString(), err),
@@ -158,6 +158,10 @@ func (r *InfinityMjxGraphDeploymentResource) Schema(ctx context.Context, req res
 }
 
 func (r *InfinityMjxGraphDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MJX Graph deployment", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxGraphDeploymentResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -181,7 +185,7 @@ func (r *InfinityMjxGraphDeploymentResource) Create(ctx context.Context, req res
 
 	createResponse, err := r.InfinityClient.Config().CreateMjxGraphDeployment(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MJX Graph deployment",
 			fmt.Sprintf("Could not create Infinity MJX Graph deployment: %s", err),
 		)
@@ -223,7 +227,7 @@ func (r *InfinityMjxGraphDeploymentResource) read(ctx context.Context, resourceI
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MJX Graph deployment with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MJX Graph deployment with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -283,6 +287,10 @@ func (r *InfinityMjxGraphDeploymentResource) Read(ctx context.Context, req resou
 }
 
 func (r *InfinityMjxGraphDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MJX Graph deployment", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxGraphDeploymentResourceModel{}
 	state := &InfinityMjxGraphDeploymentResourceModel{}
 
@@ -312,7 +320,7 @@ func (r *InfinityMjxGraphDeploymentResource) Update(ctx context.Context, req res
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMjxGraphDeployment(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MJX Graph deployment",
 			fmt.Sprintf("Could not update Infinity MJX Graph deployment: %s", err),
 		)
@@ -335,6 +343,10 @@ func (r *InfinityMjxGraphDeploymentResource) Update(ctx context.Context, req res
 }
 
 func (r *InfinityMjxGraphDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MJX Graph deployment", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMjxGraphDeploymentResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MJX Graph deployment")
@@ -346,7 +358,7 @@ func (r *InfinityMjxGraphDeploymentResource) Delete(ctx context.Context, req res
 
 	err := r.InfinityClient.Config().DeleteMjxGraphDeployment(ctx, int(state.ResourceID.ValueInt32()))
 
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MJX Graph deployment",
 			fmt.Sprintf("Could not delete Infinity MJX Graph deployment with ID %s: %s", state.ID.ValueString(), err),
@@ -285,6 +285,10 @@ func (r *InfinityMjxIntegrationResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityMjxIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MJX integration", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxIntegrationResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -352,7 +356,7 @@ func (r *InfinityMjxIntegrationResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateMjxIntegration(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MJX integration",
 			fmt.Sprintf("Could not create Infinity MJX integration: %s", err),
 		)
@@ -395,7 +399,7 @@ func (r *InfinityMjxIntegrationResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MJX integration with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MJX integration with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -515,6 +519,10 @@ func (r *InfinityMjxIntegrationResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityMjxIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MJX integration", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxIntegrationResourceModel{}
 	state := &InfinityMjxIntegrationResourceModel{}
 
@@ -583,7 +591,7 @@ func (r *InfinityMjxIntegrationResource) Update(ctx context.Context, req resourc
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMjxIntegration(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MJX integration",
 			fmt.Sprintf("Could not update Infinity MJX integration: %s", err),
 		)
@@ -608,6 +616,10 @@ func (r *InfinityMjxIntegrationResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityMjxIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MJX integration", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMjxIntegrationResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MJX integration")
@@ -619,7 +631,7 @@ func (r *InfinityMjxIntegrationResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteMjxIntegration(ctx, int(state.ResourceID.ValueInt32()))
 
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MJX integration",
 			fmt.Sprintf("Could not delete Infinity MJX integration with ID %s: %s", state.ID.ValueString(), err),
@@ -208,6 +208,10 @@ func (r *InfinityMjxMeetingProcessingRuleResource) Schema(ctx context.Context, r
 }
 
 func (r *InfinityMjxMeetingProcessingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MJX meeting processing rule", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxMeetingProcessingRuleResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -234,7 +238,7 @@ func (r *InfinityMjxMeetingProcessingRuleResource) Create(ctx context.Context, r
 
 	createResponse, err := r.InfinityClient.Config().CreateMjxMeetingProcessingRule(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MJX meeting processing rule",
 			fmt.Sprintf("Could not create Infinity MJX meeting processing rule: %s", err),
 		)
@@ -273,7 +277,7 @@ func (r *InfinityMjxMeetingProcessingRuleResource) read(ctx context.Context, res
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MJX meeting processing rule with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MJX meeting processing rule with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -322,6 +326,10 @@ func (r *InfinityMjxMeetingProcessingRuleResource) Read(ctx context.Context, req
 }
 
 func (r *InfinityMjxMeetingProcessingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MJX meeting processing rule", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMjxMeetingProcessingRuleResourceModel{}
 	state := &InfinityMjxMeetingProcessingRuleResourceModel{}
 
@@ -351,7 +359,7 @@ func (r *InfinityMjxMeetingProcessingRuleResource) Update(ctx context.Context, r
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMjxMeetingProcessingRule(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MJX meeting processing rule",
 			fmt.Sprintf("Could not update Infinity MJX meeting processing rule: %s", err),
 		)
@@ -371,6 +379,10 @@ func (r *InfinityMjxMeetingProcessingRuleResource) Update(ctx context.Context, r
 }
 
 func (r *InfinityMjxMeetingProcessingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MJX meeting processing rule", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMjxMeetingProcessingRuleResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MJX meeting processing rule")
@@ -382,7 +394,7 @@ func (r *InfinityMjxMeetingProcessingRuleResource) Delete(ctx context.Context, r
 
 	err := r.InfinityClient.Config().DeleteMjxMeetingProcessingRule(ctx, int(state.ResourceID.ValueInt32()))
 
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MJX meeting processing rule",
 			fmt.Sprintf("Could not delete Infinity MJX meeting processing rule with ID %s: %s", state.ID.ValueString(), err),
@@ -796,6 +796,10 @@ func (r *InfinityMsExchangeConnectorResource) Schema(ctx context.Context, req re
 }
 
 func (r *InfinityMsExchangeConnectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity Microsoft Exchange connector", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMsExchangeConnectorResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -947,7 +951,7 @@ func (r *InfinityMsExchangeConnectorResource) Create(ctx context.Context, req re
 
 	createResponse, err := r.InfinityClient.Config().CreateMsExchangeConnector(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity Microsoft Exchange connector",
 			fmt.Sprintf("Could not create Infinity Microsoft Exchange connector: %s", err),
 		)
@@ -992,7 +996,7 @@ func (r *InfinityMsExchangeConnectorResource) read(ctx context.Context, resource
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("microsoft Exchange connector with ID %d not found", resourceID)
+		return nil, notFoundErrorf("microsoft Exchange connector with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -1207,6 +1211,10 @@ func (r *InfinityMsExchangeConnectorResource) Read(ctx context.Context, req reso
 }
 
 func (r *InfinityMsExchangeConnectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity Microsoft Exchange connector", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMsExchangeConnectorResourceModel{}
 	state := &InfinityMsExchangeConnectorResourceModel{}
 
@@ -1408,7 +1416,7 @@ func (r *InfinityMsExchangeConnectorResource) Update(ctx context.Context, req re
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateMsExchangeConnector(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity Microsoft Exchange connector",
 			fmt.Sprintf("Could not update Infinity Microsoft Exchange connector: %s", err),
 		)
@@ -1434,6 +1442,10 @@ func (r *InfinityMsExchangeConnectorResource) Update(ctx context.Context, req re
 }
 
 func (r *InfinityMsExchangeConnectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity Microsoft Exchange connector", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMsExchangeConnectorResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity Microsoft Exchange connector")
@@ -1445,8 +1457,8 @@ func (r *InfinityMsExchangeConnectorResource) Delete(ctx context.Context, req re
 
 	err := r.InfinityClient.Config().DeleteMsExchangeConnector(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity Microsoft Exchange connector",
 			fmt.Sprintf("Could not delete Infinity Microsoft Exchange connector with ID %s: %s", state.ID.ValueString(), err),
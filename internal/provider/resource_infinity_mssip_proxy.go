@@ -130,6 +130,10 @@ func (r *InfinityMSSIPProxyResource) Schema(ctx context.Context, req resource.Sc
 }
 
 func (r *InfinityMSSIPProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity MSSIP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMSSIPProxyResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -154,7 +158,7 @@ func (r *InfinityMSSIPProxyResource) Create(ctx context.Context, req resource.Cr
 
 	createResponse, err := r.InfinityClient.Config().CreateMSSIPProxy(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity MSSIP proxy",
 			fmt.Sprintf("Could not create Infinity MSSIP proxy: %s", err),
 		)
@@ -193,7 +197,7 @@ func (r *InfinityMSSIPProxyResource) read(ctx context.Context, resourceID int) (
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("MSSIP proxy with ID %d not found", resourceID)
+		return nil, notFoundErrorf("MSSIP proxy with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -239,6 +243,10 @@ func (r *InfinityMSSIPProxyResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *InfinityMSSIPProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity MSSIP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityMSSIPProxyResourceModel{}
 	state := &InfinityMSSIPProxyResourceModel{}
 
@@ -266,7 +274,7 @@ func (r *InfinityMSSIPProxyResource) Update(ctx context.Context, req resource.Up
 
 	_, err := r.InfinityClient.Config().UpdateMSSIPProxy(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity MSSIP proxy",
 			fmt.Sprintf("Could not update Infinity MSSIP proxy with ID %d: %s", resourceID, err),
 		)
@@ -287,6 +295,10 @@ func (r *InfinityMSSIPProxyResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *InfinityMSSIPProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity MSSIP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityMSSIPProxyResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity MSSIP proxy")
@@ -298,8 +310,8 @@ func (r *InfinityMSSIPProxyResource) Delete(ctx context.Context, req resource.De
 
 	err := r.InfinityClient.Config().DeleteMSSIPProxy(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity MSSIP proxy",
 			fmt.Sprintf("Could not delete Infinity MSSIP proxy with ID %s: %s", state.ID.ValueString(), err),
@@ -99,6 +99,10 @@ func (r *InfinityNtpServerResource) Schema(ctx context.Context, req resource.Sch
 }
 
 func (r *InfinityNtpServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity NTP server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityNtpServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -117,7 +121,7 @@ func (r *InfinityNtpServerResource) Create(ctx context.Context, req resource.Cre
 
 	createResponse, err := r.InfinityClient.Config().CreateNTPServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity NTP server",
 			fmt.Sprintf("Could not create Infinity NTP server: %s", err),
 		)
@@ -156,7 +160,7 @@ func (r *InfinityNtpServerResource) read(ctx context.Context, resourceID int) (*
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("NTP server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("NTP server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -194,6 +198,10 @@ func (r *InfinityNtpServerResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *InfinityNtpServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity NTP server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityNtpServerResourceModel{}
 	state := &InfinityNtpServerResourceModel{}
 
@@ -218,7 +226,7 @@ func (r *InfinityNtpServerResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.InfinityClient.Config().UpdateNTPServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity NTP server",
 			fmt.Sprintf("Could not update Infinity NTP server with ID %d: %s", resourceID, err),
 		)
@@ -239,6 +247,10 @@ func (r *InfinityNtpServerResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *InfinityNtpServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity NTP server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityNtpServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity NTP server")
@@ -251,8 +263,8 @@ func (r *InfinityNtpServerResource) Delete(ctx context.Context, req resource.Del
 	resourceID := int(state.ResourceID.ValueInt32())
 	err := r.InfinityClient.Config().DeleteNTPServer(ctx, resourceID)
 
-	// Ignore 404 Not Found errors and lookup on delete, as this means the resource is already gone.
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete, as this means the resource is already gone.
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity NTP server",
 			fmt.Sprintf("Could not delete Infinity NTP server with ID %d: %s", resourceID, err),
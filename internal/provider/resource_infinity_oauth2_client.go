@@ -99,6 +99,10 @@ func (r *InfinityOAuth2ClientResource) Schema(ctx context.Context, req resource.
 }
 
 func (r *InfinityOAuth2ClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity OAuth2 client", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityOAuth2ClientResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -113,7 +117,7 @@ func (r *InfinityOAuth2ClientResource) Create(ctx context.Context, req resource.
 
 	createResponse, err := r.InfinityClient.Config().CreateOAuth2Client(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity OAuth2 client",
 			fmt.Sprintf("Could not create Infinity OAuth2 client: %s", err),
 		)
@@ -176,7 +180,7 @@ func (r *InfinityOAuth2ClientResource) read(ctx context.Context, clientID string
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("OAuth2 client with ID %s not found", clientID)
+		return nil, notFoundErrorf("OAuth2 client with ID %s not found", clientID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -226,6 +230,10 @@ func (r *InfinityOAuth2ClientResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *InfinityOAuth2ClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity OAuth2 client", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityOAuth2ClientResourceModel{}
 	state := &InfinityOAuth2ClientResourceModel{}
 
@@ -243,7 +251,7 @@ func (r *InfinityOAuth2ClientResource) Update(ctx context.Context, req resource.
 	clientID := state.ClientID.ValueString()
 	_, err := r.InfinityClient.Config().UpdateOAuth2Client(ctx, clientID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity OAuth2 client",
 			fmt.Sprintf("Could not update Infinity OAuth2 client: %s", err),
 		)
@@ -267,6 +275,10 @@ func (r *InfinityOAuth2ClientResource) Update(ctx context.Context, req resource.
 }
 
 func (r *InfinityOAuth2ClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity OAuth2 client", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityOAuth2ClientResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity OAuth2 client")
@@ -278,8 +290,8 @@ func (r *InfinityOAuth2ClientResource) Delete(ctx context.Context, req resource.
 
 	err := r.InfinityClient.Config().DeleteOAuth2Client(ctx, state.ClientID.ValueString())
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity OAuth2 client",
 			fmt.Sprintf("Could not delete Infinity OAuth2 client with ID %s: %s", state.ID.ValueString(), err),
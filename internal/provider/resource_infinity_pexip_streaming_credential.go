@@ -97,6 +97,10 @@ func (r *InfinityPexipStreamingCredentialResource) Schema(ctx context.Context, r
 }
 
 func (r *InfinityPexipStreamingCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity Pexip Streaming credential", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityPexipStreamingCredentialResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -111,7 +115,7 @@ func (r *InfinityPexipStreamingCredentialResource) Create(ctx context.Context, r
 
 	createResponse, err := r.InfinityClient.Config().CreatePexipStreamingCredential(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity Pexip Streaming credential",
 			fmt.Sprintf("Could not create Infinity Pexip Streaming credential: %s", err),
 		)
@@ -150,7 +154,7 @@ func (r *InfinityPexipStreamingCredentialResource) read(ctx context.Context, res
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("pexip Streaming credential with ID %d not found", resourceID)
+		return nil, notFoundErrorf("pexip Streaming credential with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -188,6 +192,10 @@ func (r *InfinityPexipStreamingCredentialResource) Read(ctx context.Context, req
 }
 
 func (r *InfinityPexipStreamingCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity Pexip Streaming credential", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityPexipStreamingCredentialResourceModel{}
 	state := &InfinityPexipStreamingCredentialResourceModel{}
 
@@ -205,7 +213,7 @@ func (r *InfinityPexipStreamingCredentialResource) Update(ctx context.Context, r
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdatePexipStreamingCredential(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity Pexip Streaming credential",
 			fmt.Sprintf("Could not update Infinity Pexip Streaming credential: %s", err),
 		)
@@ -226,6 +234,10 @@ func (r *InfinityPexipStreamingCredentialResource) Update(ctx context.Context, r
 }
 
 func (r *InfinityPexipStreamingCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity Pexip Streaming credential", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityPexipStreamingCredentialResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity Pexip Streaming credential")
@@ -237,8 +249,8 @@ func (r *InfinityPexipStreamingCredentialResource) Delete(ctx context.Context, r
 
 	err := r.InfinityClient.Config().DeletePexipStreamingCredential(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity Pexip Streaming credential",
 			fmt.Sprintf("Could not delete Infinity Pexip Streaming credential with ID %s: %s", state.ID.ValueString(), err),
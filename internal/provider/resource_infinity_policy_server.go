@@ -232,6 +232,10 @@ func (r *InfinityPolicyServerResource) Schema(ctx context.Context, req resource.
 }
 
 func (r *InfinityPolicyServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity policy server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityPolicyServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -278,7 +282,7 @@ func (r *InfinityPolicyServerResource) Create(ctx context.Context, req resource.
 
 	createResponse, err := r.InfinityClient.Config().CreatePolicyServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity policy server",
 			fmt.Sprintf("Could not create Infinity policy server: %s", err),
 		)
@@ -317,7 +321,7 @@ func (r *InfinityPolicyServerResource) read(ctx context.Context, resourceID int,
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("policy server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("policy server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -371,6 +375,10 @@ func (r *InfinityPolicyServerResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *InfinityPolicyServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity policy server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityPolicyServerResourceModel{}
 	state := &InfinityPolicyServerResourceModel{}
 
@@ -432,7 +440,7 @@ func (r *InfinityPolicyServerResource) Update(ctx context.Context, req resource.
 
 	_, err := r.InfinityClient.Config().UpdatePolicyServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity policy server",
 			fmt.Sprintf("Could not update Infinity policy server with ID %d: %s", resourceID, err),
 		)
@@ -453,6 +461,10 @@ func (r *InfinityPolicyServerResource) Update(ctx context.Context, req resource.
 }
 
 func (r *InfinityPolicyServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity policy server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityPolicyServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity policy server")
@@ -464,8 +476,8 @@ func (r *InfinityPolicyServerResource) Delete(ctx context.Context, req resource.
 
 	err := r.InfinityClient.Config().DeletePolicyServer(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity policy server",
 			fmt.Sprintf("Could not delete Infinity policy server with ID %s: %s", state.ID.ValueString(), err),
@@ -123,6 +123,10 @@ func (r *InfinityRecurringConferenceResource) Schema(ctx context.Context, req re
 }
 
 func (r *InfinityRecurringConferenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity recurring conference", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityRecurringConferenceResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -146,7 +150,7 @@ func (r *InfinityRecurringConferenceResource) Create(ctx context.Context, req re
 
 	createResponse, err := r.InfinityClient.Config().CreateRecurringConference(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity recurring conference",
 			fmt.Sprintf("Could not create Infinity recurring conference: %s", err),
 		)
@@ -185,7 +189,7 @@ func (r *InfinityRecurringConferenceResource) read(ctx context.Context, resource
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("recurring conference with ID %d not found", resourceID)
+		return nil, notFoundErrorf("recurring conference with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -233,6 +237,10 @@ func (r *InfinityRecurringConferenceResource) Read(ctx context.Context, req reso
 }
 
 func (r *InfinityRecurringConferenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity recurring conference", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityRecurringConferenceResourceModel{}
 	state := &InfinityRecurringConferenceResourceModel{}
 
@@ -268,7 +276,7 @@ func (r *InfinityRecurringConferenceResource) Update(ctx context.Context, req re
 
 	_, err := r.InfinityClient.Config().UpdateRecurringConference(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity recurring conference",
 			fmt.Sprintf("Could not update Infinity recurring conference with ID %d: %s", resourceID, err),
 		)
@@ -289,6 +297,10 @@ func (r *InfinityRecurringConferenceResource) Update(ctx context.Context, req re
 }
 
 func (r *InfinityRecurringConferenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity recurring conference", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityRecurringConferenceResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity recurring conference")
@@ -300,8 +312,8 @@ func (r *InfinityRecurringConferenceResource) Delete(ctx context.Context, req re
 
 	err := r.InfinityClient.Config().DeleteRecurringConference(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity recurring conference",
 			fmt.Sprintf("Could not delete Infinity recurring conference with ID %s: %s", state.ID.ValueString(), err),
@@ -178,6 +178,10 @@ func (r *InfinityRegistrationResource) Schema(ctx context.Context, req resource.
 }
 
 func (r *InfinityRegistrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity registration configuration", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, Create is actually Update since the resource always exists
 	plan := &InfinityRegistrationResourceModel{}
 
@@ -242,7 +246,7 @@ func (r *InfinityRegistrationResource) Create(ctx context.Context, req resource.
 	var result config.Registration
 	err := r.InfinityClient.PatchJSON(ctx, endpoint, updateRequest, &result)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity registration configuration",
 			fmt.Sprintf("Could not create Infinity registration configuration: %s", err),
 		)
@@ -272,7 +276,7 @@ func (r *InfinityRegistrationResource) read(ctx context.Context, planPushToken t
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("registration configuration not found")
+		return nil, notFoundErrorf("registration configuration not found")
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -324,6 +328,10 @@ func (r *InfinityRegistrationResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *InfinityRegistrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity registration configuration", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityRegistrationResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -387,7 +395,7 @@ func (r *InfinityRegistrationResource) Update(ctx context.Context, req resource.
 	var result config.Registration
 	err := r.InfinityClient.PatchJSON(ctx, endpoint, updateRequest, &result)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity registration configuration",
 			fmt.Sprintf("Could not update Infinity registration configuration: %s", err),
 		)
@@ -408,6 +416,10 @@ func (r *InfinityRegistrationResource) Update(ctx context.Context, req resource.
 }
 
 func (r *InfinityRegistrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity registration configuration", &resp.Diagnostics) {
+		return
+	}
+
 	// For singleton resources, delete means resetting all fields to their API defaults.
 	tflog.Info(ctx, "Resetting Infinity registration configuration to defaults")
 
@@ -439,7 +451,7 @@ func (r *InfinityRegistrationResource) Delete(ctx context.Context, req resource.
 	endpoint := "configuration/v1/registration/1/"
 	var result config.Registration
 	err := r.InfinityClient.PatchJSON(ctx, endpoint, updateRequest, &result)
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Resetting Infinity registration configuration",
 			fmt.Sprintf("Could not reset Infinity registration configuration: %s", err),
@@ -94,6 +94,10 @@ func (r *InfinityRoleResource) Schema(ctx context.Context, req resource.SchemaRe
 }
 
 func (r *InfinityRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity role", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityRoleResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -122,7 +126,7 @@ func (r *InfinityRoleResource) Create(ctx context.Context, req resource.CreateRe
 
 	createResponse, err := r.InfinityClient.Config().CreateRole(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity role",
 			fmt.Sprintf("Could not create Infinity role: %s", err),
 		)
@@ -161,7 +165,7 @@ func (r *InfinityRoleResource) read(ctx context.Context, resourceID int) (*Infin
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("role with ID %d not found", resourceID)
+		return nil, notFoundErrorf("role with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -209,6 +213,10 @@ func (r *InfinityRoleResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *InfinityRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity role", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityRoleResourceModel{}
 	state := &InfinityRoleResourceModel{}
 
@@ -234,7 +242,7 @@ func (r *InfinityRoleResource) Update(ctx context.Context, req resource.UpdateRe
 
 	_, err := r.InfinityClient.Config().UpdateRole(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity role",
 			fmt.Sprintf("Could not update Infinity role with ID %d: %s", resourceID, err),
 		)
@@ -255,6 +263,10 @@ func (r *InfinityRoleResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *InfinityRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity role", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityRoleResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity role")
@@ -266,8 +278,8 @@ func (r *InfinityRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	err := r.InfinityClient.Config().DeleteRole(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity role",
 			fmt.Sprintf("Could not delete Infinity role with ID %s: %s", state.ID.ValueString(), err),
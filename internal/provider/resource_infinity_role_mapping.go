@@ -115,6 +115,10 @@ func (r *InfinityRoleMappingResource) Schema(ctx context.Context, req resource.S
 }
 
 func (r *InfinityRoleMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity role mapping", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityRoleMappingResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -140,7 +144,7 @@ func (r *InfinityRoleMappingResource) Create(ctx context.Context, req resource.C
 
 	createResponse, err := r.InfinityClient.Config().CreateRoleMapping(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity role mapping",
 			fmt.Sprintf("Could not create Infinity role mapping: %s", err),
 		)
@@ -179,7 +183,7 @@ func (r *InfinityRoleMappingResource) read(ctx context.Context, resourceID int)
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("role mapping with ID %d not found", resourceID)
+		return nil, notFoundErrorf("role mapping with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -229,6 +233,10 @@ func (r *InfinityRoleMappingResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *InfinityRoleMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity role mapping", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityRoleMappingResourceModel{}
 	state := &InfinityRoleMappingResourceModel{}
 
@@ -257,7 +265,7 @@ func (r *InfinityRoleMappingResource) Update(ctx context.Context, req resource.U
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateRoleMapping(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity role mapping",
 			fmt.Sprintf("Could not update Infinity role mapping: %s", err),
 		)
@@ -278,6 +286,10 @@ func (r *InfinityRoleMappingResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *InfinityRoleMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity role mapping", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityRoleMappingResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity role mapping")
@@ -289,8 +301,8 @@ func (r *InfinityRoleMappingResource) Delete(ctx context.Context, req resource.D
 
 	err := r.InfinityClient.Config().DeleteRoleMapping(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity role mapping",
 			fmt.Sprintf("Could not delete Infinity role mapping with ID %s: %s", state.ID.ValueString(), err),
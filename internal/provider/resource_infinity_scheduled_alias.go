@@ -146,6 +146,10 @@ func (r *InfinityScheduledAliasResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityScheduledAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity scheduled alias", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityScheduledAliasResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -170,7 +174,7 @@ func (r *InfinityScheduledAliasResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateScheduledAlias(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity scheduled alias",
 			fmt.Sprintf("Could not create Infinity scheduled alias: %s", err),
 		)
@@ -209,7 +213,7 @@ func (r *InfinityScheduledAliasResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("scheduled alias with ID %d not found", resourceID)
+		return nil, notFoundErrorf("scheduled alias with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -265,6 +269,10 @@ func (r *InfinityScheduledAliasResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityScheduledAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity scheduled alias", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityScheduledAliasResourceModel{}
 	state := &InfinityScheduledAliasResourceModel{}
 
@@ -300,7 +308,7 @@ func (r *InfinityScheduledAliasResource) Update(ctx context.Context, req resourc
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateScheduledAlias(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity scheduled alias",
 			fmt.Sprintf("Could not update Infinity scheduled alias: %s", err),
 		)
@@ -321,6 +329,10 @@ func (r *InfinityScheduledAliasResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityScheduledAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity scheduled alias", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityScheduledAliasResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity scheduled alias")
@@ -332,8 +344,8 @@ func (r *InfinityScheduledAliasResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteScheduledAlias(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity scheduled alias",
 			fmt.Sprintf("Could not delete Infinity scheduled alias with ID %s: %s", state.ID.ValueString(), err),
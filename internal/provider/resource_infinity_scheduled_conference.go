@@ -145,6 +145,10 @@ func (r *InfinityScheduledConferenceResource) Schema(ctx context.Context, req re
 }
 
 func (r *InfinityScheduledConferenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity scheduled conference", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityScheduledConferenceResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -200,7 +204,7 @@ func (r *InfinityScheduledConferenceResource) Create(ctx context.Context, req re
 
 	createResponse, err := r.InfinityClient.Config().CreateScheduledConference(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity scheduled conference",
 			fmt.Sprintf("Could not create Infinity scheduled conference: %s", err),
 		)
@@ -239,7 +243,7 @@ func (r *InfinityScheduledConferenceResource) read(ctx context.Context, resource
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("scheduled conference with ID %d not found", resourceID)
+		return nil, notFoundErrorf("scheduled conference with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -291,6 +295,10 @@ func (r *InfinityScheduledConferenceResource) Read(ctx context.Context, req reso
 }
 
 func (r *InfinityScheduledConferenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity scheduled conference", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityScheduledConferenceResourceModel{}
 	state := &InfinityScheduledConferenceResourceModel{}
 
@@ -357,7 +365,7 @@ func (r *InfinityScheduledConferenceResource) Update(ctx context.Context, req re
 
 	_, err := r.InfinityClient.Config().UpdateScheduledConference(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity scheduled conference",
 			fmt.Sprintf("Could not update Infinity scheduled conference with ID %d: %s", resourceID, err),
 		)
@@ -378,6 +386,10 @@ func (r *InfinityScheduledConferenceResource) Update(ctx context.Context, req re
 }
 
 func (r *InfinityScheduledConferenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity scheduled conference", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityScheduledConferenceResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity scheduled conference")
@@ -389,8 +401,8 @@ func (r *InfinityScheduledConferenceResource) Delete(ctx context.Context, req re
 
 	err := r.InfinityClient.Config().DeleteScheduledConference(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity scheduled conference",
 			fmt.Sprintf("Could not delete Infinity scheduled conference with ID %s: %s", state.ID.ValueString(), err),
@@ -215,6 +215,10 @@ func (r *InfinityScheduledScalingResource) Schema(ctx context.Context, req resou
 }
 
 func (r *InfinityScheduledScalingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity scheduled scaling policy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityScheduledScalingResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -244,7 +248,7 @@ func (r *InfinityScheduledScalingResource) Create(ctx context.Context, req resou
 
 	createResponse, err := r.InfinityClient.Config().CreateScheduledScaling(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity scheduled scaling policy",
 			fmt.Sprintf("Could not create Infinity scheduled scaling policy: %s", err),
 		)
@@ -283,7 +287,7 @@ func (r *InfinityScheduledScalingResource) read(ctx context.Context, resourceID
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("scheduled scaling policy with ID %d not found", resourceID)
+		return nil, notFoundErrorf("scheduled scaling policy with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -342,6 +346,10 @@ func (r *InfinityScheduledScalingResource) Read(ctx context.Context, req resourc
 }
 
 func (r *InfinityScheduledScalingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity scheduled scaling policy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityScheduledScalingResourceModel{}
 	state := &InfinityScheduledScalingResourceModel{}
 
@@ -415,7 +423,7 @@ func (r *InfinityScheduledScalingResource) Update(ctx context.Context, req resou
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateScheduledScaling(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity scheduled scaling policy",
 			fmt.Sprintf("Could not update Infinity scheduled scaling policy: %s", err),
 		)
@@ -436,6 +444,10 @@ func (r *InfinityScheduledScalingResource) Update(ctx context.Context, req resou
 }
 
 func (r *InfinityScheduledScalingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity scheduled scaling policy", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityScheduledScalingResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity scheduled scaling policy")
@@ -447,8 +459,8 @@ func (r *InfinityScheduledScalingResource) Delete(ctx context.Context, req resou
 
 	err := r.InfinityClient.Config().DeleteScheduledScaling(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity scheduled scaling policy",
 			fmt.Sprintf("Could not delete Infinity scheduled scaling policy with ID %s: %s", state.ID.ValueString(), err),
@@ -107,6 +107,10 @@ func (r *InfinitySIPCredentialResource) Schema(ctx context.Context, req resource
 }
 
 func (r *InfinitySIPCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity SIP credential", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySIPCredentialResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -122,7 +126,7 @@ func (r *InfinitySIPCredentialResource) Create(ctx context.Context, req resource
 
 	createResponse, err := r.InfinityClient.Config().CreateSIPCredential(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity SIP credential",
 			fmt.Sprintf("Could not create Infinity SIP credential: %s", err),
 		)
@@ -161,7 +165,7 @@ func (r *InfinitySIPCredentialResource) read(ctx context.Context, resourceID int
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("SIP credential with ID %d not found", resourceID)
+		return nil, notFoundErrorf("SIP credential with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -204,6 +208,10 @@ func (r *InfinitySIPCredentialResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *InfinitySIPCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity SIP credential", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySIPCredentialResourceModel{}
 	state := &InfinitySIPCredentialResourceModel{}
 
@@ -222,7 +230,7 @@ func (r *InfinitySIPCredentialResource) Update(ctx context.Context, req resource
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateSIPCredential(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity SIP credential",
 			fmt.Sprintf("Could not update Infinity SIP credential: %s", err),
 		)
@@ -243,6 +251,10 @@ func (r *InfinitySIPCredentialResource) Update(ctx context.Context, req resource
 }
 
 func (r *InfinitySIPCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity SIP credential", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySIPCredentialResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity SIP credential")
@@ -254,8 +266,8 @@ func (r *InfinitySIPCredentialResource) Delete(ctx context.Context, req resource
 
 	err := r.InfinityClient.Config().DeleteSIPCredential(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity SIP credential",
 			fmt.Sprintf("Could not delete Infinity SIP credential with ID %s: %s", state.ID.ValueString(), err),
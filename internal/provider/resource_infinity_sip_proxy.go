@@ -128,6 +128,10 @@ func (r *InfinitySIPProxyResource) Schema(ctx context.Context, req resource.Sche
 }
 
 func (r *InfinitySIPProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity SIP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySIPProxyResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -152,7 +156,7 @@ func (r *InfinitySIPProxyResource) Create(ctx context.Context, req resource.Crea
 
 	createResponse, err := r.InfinityClient.Config().CreateSIPProxy(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity SIP proxy",
 			fmt.Sprintf("Could not create Infinity SIP proxy: %s", err),
 		)
@@ -191,7 +195,7 @@ func (r *InfinitySIPProxyResource) read(ctx context.Context, resourceID int) (*I
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("SIP proxy with ID %d not found", resourceID)
+		return nil, notFoundErrorf("SIP proxy with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -237,6 +241,10 @@ func (r *InfinitySIPProxyResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *InfinitySIPProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity SIP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySIPProxyResourceModel{}
 	state := &InfinitySIPProxyResourceModel{}
 
@@ -262,7 +270,7 @@ func (r *InfinitySIPProxyResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.InfinityClient.Config().UpdateSIPProxy(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity SIP proxy",
 			fmt.Sprintf("Could not update Infinity SIP proxy with ID %d: %s", resourceID, err),
 		)
@@ -283,6 +291,10 @@ func (r *InfinitySIPProxyResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *InfinitySIPProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity SIP proxy", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySIPProxyResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity SIP proxy")
@@ -294,8 +306,8 @@ func (r *InfinitySIPProxyResource) Delete(ctx context.Context, req resource.Dele
 
 	err := r.InfinityClient.Config().DeleteSIPProxy(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity SIP proxy",
 			fmt.Sprintf("Could not delete Infinity SIP proxy with ID %s: %s", state.ID.ValueString(), err),
@@ -163,6 +163,10 @@ func (r *InfinitySMTPServerResource) Schema(ctx context.Context, req resource.Sc
 }
 
 func (r *InfinitySMTPServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity SMTP server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySMTPServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -183,7 +187,7 @@ func (r *InfinitySMTPServerResource) Create(ctx context.Context, req resource.Cr
 
 	createResponse, err := r.InfinityClient.Config().CreateSMTPServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity SMTP server",
 			fmt.Sprintf("Could not create Infinity SMTP server: %s", err),
 		)
@@ -222,7 +226,7 @@ func (r *InfinitySMTPServerResource) read(ctx context.Context, resourceID int, p
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("SMTP server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("SMTP server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -266,6 +270,10 @@ func (r *InfinitySMTPServerResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *InfinitySMTPServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity SMTP server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySMTPServerResourceModel{}
 	state := &InfinitySMTPServerResourceModel{}
 
@@ -294,7 +302,7 @@ func (r *InfinitySMTPServerResource) Update(ctx context.Context, req resource.Up
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateSMTPServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity SMTP server",
 			fmt.Sprintf("Could not update Infinity SMTP server: %s", err),
 		)
@@ -315,6 +323,10 @@ func (r *InfinitySMTPServerResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *InfinitySMTPServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity SMTP server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySMTPServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity SMTP server")
@@ -326,8 +338,8 @@ func (r *InfinitySMTPServerResource) Delete(ctx context.Context, req resource.De
 
 	err := r.InfinityClient.Config().DeleteSMTPServer(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity SMTP server",
 			fmt.Sprintf("Could not delete Infinity SMTP server with ID %s: %s", state.ID.ValueString(), err),
@@ -130,6 +130,10 @@ func (r *InfinitySnmpNetworkManagementSystemResource) Schema(ctx context.Context
 }
 
 func (r *InfinitySnmpNetworkManagementSystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity SNMP network management system", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySnmpNetworkManagementSystemResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -147,7 +151,7 @@ func (r *InfinitySnmpNetworkManagementSystemResource) Create(ctx context.Context
 
 	createResponse, err := r.InfinityClient.Config().CreateSnmpNetworkManagementSystem(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity SNMP network management system",
 			fmt.Sprintf("Could not create Infinity SNMP network management system: %s", err),
 		)
@@ -186,7 +190,7 @@ func (r *InfinitySnmpNetworkManagementSystemResource) read(ctx context.Context,
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("SNMP network management system with ID %d not found", resourceID)
+		return nil, notFoundErrorf("SNMP network management system with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -227,6 +231,10 @@ func (r *InfinitySnmpNetworkManagementSystemResource) Read(ctx context.Context,
 }
 
 func (r *InfinitySnmpNetworkManagementSystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity SNMP network management system", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySnmpNetworkManagementSystemResourceModel{}
 	state := &InfinitySnmpNetworkManagementSystemResourceModel{}
 
@@ -252,7 +260,7 @@ func (r *InfinitySnmpNetworkManagementSystemResource) Update(ctx context.Context
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateSnmpNetworkManagementSystem(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity SNMP network management system",
 			fmt.Sprintf("Could not update Infinity SNMP network management system: %s", err),
 		)
@@ -273,6 +281,10 @@ func (r *InfinitySnmpNetworkManagementSystemResource) Update(ctx context.Context
 }
 
 func (r *InfinitySnmpNetworkManagementSystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity SNMP network management system", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySnmpNetworkManagementSystemResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity SNMP network management system")
@@ -284,8 +296,8 @@ func (r *InfinitySnmpNetworkManagementSystemResource) Delete(ctx context.Context
 
 	err := r.InfinityClient.Config().DeleteSnmpNetworkManagementSystem(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity SNMP network management system",
 			fmt.Sprintf("Could not delete Infinity SNMP network management system with ID %s: %s", state.ID.ValueString(), err),
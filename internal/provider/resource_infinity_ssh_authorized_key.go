@@ -109,6 +109,10 @@ func (r *InfinitySSHAuthorizedKeyResource) Schema(ctx context.Context, req resou
 }
 
 func (r *InfinitySSHAuthorizedKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity SSH authorized key", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySSHAuthorizedKeyResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -132,7 +136,7 @@ func (r *InfinitySSHAuthorizedKeyResource) Create(ctx context.Context, req resou
 
 	createResponse, err := r.InfinityClient.Config().CreateSSHAuthorizedKey(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity SSH authorized key",
 			fmt.Sprintf("Could not create Infinity SSH authorized key: %s", err),
 		)
@@ -171,7 +175,7 @@ func (r *InfinitySSHAuthorizedKeyResource) read(ctx context.Context, resourceID
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("SSH authorized key with ID %d not found", resourceID)
+		return nil, notFoundErrorf("SSH authorized key with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -221,6 +225,10 @@ func (r *InfinitySSHAuthorizedKeyResource) Read(ctx context.Context, req resourc
 }
 
 func (r *InfinitySSHAuthorizedKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity SSH authorized key", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySSHAuthorizedKeyResourceModel{}
 	state := &InfinitySSHAuthorizedKeyResourceModel{}
 
@@ -248,7 +256,7 @@ func (r *InfinitySSHAuthorizedKeyResource) Update(ctx context.Context, req resou
 
 	_, err := r.InfinityClient.Config().UpdateSSHAuthorizedKey(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity SSH authorized key",
 			fmt.Sprintf("Could not update Infinity SSH authorized key with ID %d: %s", resourceID, err),
 		)
@@ -269,6 +277,10 @@ func (r *InfinitySSHAuthorizedKeyResource) Update(ctx context.Context, req resou
 }
 
 func (r *InfinitySSHAuthorizedKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity SSH authorized key", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySSHAuthorizedKeyResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity SSH authorized key")
@@ -280,8 +292,8 @@ func (r *InfinitySSHAuthorizedKeyResource) Delete(ctx context.Context, req resou
 
 	err := r.InfinityClient.Config().DeleteSSHAuthorizedKey(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity SSH authorized key",
 			fmt.Sprintf("Could not delete Infinity SSH authorized key with ID %s: %s", state.ID.ValueString(), err),
@@ -115,6 +115,10 @@ func (r *InfinityStaticRouteResource) Schema(ctx context.Context, req resource.S
 }
 
 func (r *InfinityStaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity static route", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityStaticRouteResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -131,7 +135,7 @@ func (r *InfinityStaticRouteResource) Create(ctx context.Context, req resource.C
 
 	createResponse, err := r.InfinityClient.Config().CreateStaticRoute(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity static route",
 			fmt.Sprintf("Could not create Infinity static route: %s", err),
 		)
@@ -170,7 +174,7 @@ func (r *InfinityStaticRouteResource) read(ctx context.Context, resourceID int)
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("static route with ID %d not found", resourceID)
+		return nil, notFoundErrorf("static route with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -210,6 +214,10 @@ func (r *InfinityStaticRouteResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *InfinityStaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity static route", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityStaticRouteResourceModel{}
 	state := &InfinityStaticRouteResourceModel{}
 
@@ -230,7 +238,7 @@ func (r *InfinityStaticRouteResource) Update(ctx context.Context, req resource.U
 
 	_, err := r.InfinityClient.Config().UpdateStaticRoute(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity static route",
 			fmt.Sprintf("Could not update Infinity static route with ID %d: %s", resourceID, err),
 		)
@@ -251,6 +259,10 @@ func (r *InfinityStaticRouteResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *InfinityStaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity static route", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityStaticRouteResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity static route")
@@ -262,8 +274,8 @@ func (r *InfinityStaticRouteResource) Delete(ctx context.Context, req resource.D
 
 	err := r.InfinityClient.Config().DeleteStaticRoute(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity static route",
 			fmt.Sprintf("Could not delete Infinity static route with ID %s: %s", state.ID.ValueString(), err),
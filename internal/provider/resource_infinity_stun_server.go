@@ -119,6 +119,10 @@ func (r *InfinitySTUNServerResource) Schema(ctx context.Context, req resource.Sc
 }
 
 func (r *InfinitySTUNServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity STUN server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySTUNServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -135,7 +139,7 @@ func (r *InfinitySTUNServerResource) Create(ctx context.Context, req resource.Cr
 
 	createResponse, err := r.InfinityClient.Config().CreateSTUNServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity STUN server",
 			fmt.Sprintf("Could not create Infinity STUN server: %s", err),
 		)
@@ -174,7 +178,7 @@ func (r *InfinitySTUNServerResource) read(ctx context.Context, resourceID int) (
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("STUN server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("STUN server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -214,6 +218,10 @@ func (r *InfinitySTUNServerResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *InfinitySTUNServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity STUN server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySTUNServerResourceModel{}
 	state := &InfinitySTUNServerResourceModel{}
 
@@ -239,7 +247,7 @@ func (r *InfinitySTUNServerResource) Update(ctx context.Context, req resource.Up
 
 	_, err := r.InfinityClient.Config().UpdateSTUNServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity STUN server",
 			fmt.Sprintf("Could not update Infinity STUN server with ID %d: %s", resourceID, err),
 		)
@@ -260,6 +268,10 @@ func (r *InfinitySTUNServerResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *InfinitySTUNServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity STUN server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySTUNServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity STUN server")
@@ -271,8 +283,8 @@ func (r *InfinitySTUNServerResource) Delete(ctx context.Context, req resource.De
 
 	err := r.InfinityClient.Config().DeleteSTUNServer(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity STUN server",
 			fmt.Sprintf("Could not delete Infinity STUN server with ID %s: %s", state.ID.ValueString(), err),
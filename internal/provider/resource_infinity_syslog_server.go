@@ -144,6 +144,10 @@ func (r *InfinitySyslogServerResource) Schema(ctx context.Context, req resource.
 }
 
 func (r *InfinitySyslogServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity syslog server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySyslogServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -163,7 +167,7 @@ func (r *InfinitySyslogServerResource) Create(ctx context.Context, req resource.
 
 	createResponse, err := r.InfinityClient.Config().CreateSyslogServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity syslog server",
 			fmt.Sprintf("Could not create Infinity syslog server: %s", err),
 		)
@@ -202,7 +206,7 @@ func (r *InfinitySyslogServerResource) read(ctx context.Context, resourceID int)
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("syslog server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("syslog server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -245,6 +249,10 @@ func (r *InfinitySyslogServerResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *InfinitySyslogServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity syslog server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySyslogServerResourceModel{}
 	state := &InfinitySyslogServerResourceModel{}
 
@@ -280,7 +288,7 @@ func (r *InfinitySyslogServerResource) Update(ctx context.Context, req resource.
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateSyslogServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity syslog server",
 			fmt.Sprintf("Could not update Infinity syslog server: %s", err),
 		)
@@ -301,6 +309,10 @@ func (r *InfinitySyslogServerResource) Update(ctx context.Context, req resource.
 }
 
 func (r *InfinitySyslogServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity syslog server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySyslogServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity syslog server")
@@ -312,8 +324,8 @@ func (r *InfinitySyslogServerResource) Delete(ctx context.Context, req resource.
 
 	err := r.InfinityClient.Config().DeleteSyslogServer(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity syslog server",
 			fmt.Sprintf("Could not delete Infinity syslog server with ID %s: %s", state.ID.ValueString(), err),
@@ -296,6 +296,10 @@ func (r *InfinitySystemLocationResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinitySystemLocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity system location", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySystemLocationResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -410,7 +414,7 @@ func (r *InfinitySystemLocationResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateSystemLocation(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity system location",
 			fmt.Sprintf("Could not create Infinity system location: %s", err),
 		)
@@ -449,7 +453,7 @@ func (r *InfinitySystemLocationResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("system location with ID %d not found", resourceID)
+		return nil, notFoundErrorf("system location with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -579,6 +583,10 @@ func (r *InfinitySystemLocationResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinitySystemLocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity system location", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySystemLocationResourceModel{}
 	state := &InfinitySystemLocationResourceModel{}
 
@@ -697,7 +705,7 @@ func (r *InfinitySystemLocationResource) Update(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateSystemLocation(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity system location",
 			fmt.Sprintf("Could not update Infinity system location with ID %d: %s", resourceID, err),
 		)
@@ -718,6 +726,10 @@ func (r *InfinitySystemLocationResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinitySystemLocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity system location", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySystemLocationResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity system location")
@@ -729,8 +741,8 @@ func (r *InfinitySystemLocationResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteSystemLocation(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity system location",
 			fmt.Sprintf("Could not delete Infinity system location with ID %s: %s", state.ID.ValueString(), err),
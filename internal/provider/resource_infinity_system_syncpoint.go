@@ -8,12 +8,19 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,14 +32,24 @@ var (
 	_ resource.ResourceWithImportState = (*InfinitySystemSyncpointResource)(nil)
 )
 
+const (
+	defaultSystemSyncpointTimeout      = 10 * time.Minute
+	defaultSystemSyncpointPollInterval = 5 * time.Second
+)
+
 type InfinitySystemSyncpointResource struct {
 	InfinityClient InfinityClient
+
+	pollInterval time.Duration
 }
 
 type InfinitySystemSyncpointResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	ResourceID   types.Int32  `tfsdk:"resource_id"`
-	CreationTime types.String `tfsdk:"creation_time"`
+	ID           types.String   `tfsdk:"id"`
+	ResourceID   types.Int32    `tfsdk:"resource_id"`
+	CreationTime types.String   `tfsdk:"creation_time"`
+	WaitForSync  types.Bool     `tfsdk:"wait_for_sync"`
+	NodeLag      types.Map      `tfsdk:"node_lag"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *InfinitySystemSyncpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -77,12 +94,35 @@ func (r *InfinitySystemSyncpointResource) Schema(ctx context.Context, req resour
 				Computed:            true,
 				MarkdownDescription: "The timestamp when this system syncpoint was created",
 			},
+			"wait_for_sync": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to wait after creating the syncpoint until every Conferencing Node has reached it, i.e. reports that it is in sync after the syncpoint was created. How long to wait is set with `timeouts.create`. Defaults to `false`.",
+			},
+			"node_lag": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "How long each Conferencing Node took to reach the syncpoint, by node name, e.g. `4s`. Only set if `wait_for_sync` is set.",
+			},
 		},
-		MarkdownDescription: "Manages a system syncpoint with the Infinity service. System syncpoints are critical for multi-site deployments and provide system synchronization points for coordinated operations. Note: This resource only supports creation and reading - syncpoints cannot be updated or deleted once created.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+		MarkdownDescription: "Manages a system syncpoint with the Infinity service. System syncpoints are critical for multi-site deployments and provide system synchronization points for coordinated operations. If `wait_for_sync` is set, creating the syncpoint waits until every Conferencing Node has reached it, so that it marks the point at which the configuration is consistent. Note: This resource only supports creation and reading - syncpoints cannot be updated or deleted once created.",
 	}
 }
 
 func (r *InfinitySystemSyncpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity system syncpoint", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySystemSyncpointResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -94,7 +134,7 @@ func (r *InfinitySystemSyncpointResource) Create(ctx context.Context, req resour
 
 	createResponse, err := r.InfinityClient.Config().CreateSystemSyncpoint(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity system syncpoint",
 			fmt.Sprintf("Could not create Infinity system syncpoint: %s", err),
 		)
@@ -120,6 +160,33 @@ func (r *InfinitySystemSyncpointResource) Create(ctx context.Context, req resour
 		return
 	}
 	tflog.Trace(ctx, fmt.Sprintf("created Infinity system syncpoint with ID: %s", model.ID))
+	model.WaitForSync = plan.WaitForSync
+	model.NodeLag = types.MapNull(types.StringType)
+	model.Timeouts = plan.Timeouts
+
+	if plan.WaitForSync.ValueBool() {
+		timeout, diags := plan.Timeouts.Create(ctx, defaultSystemSyncpointTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Waiting up to %s for every Conferencing Node to reach Infinity system syncpoint %d", timeout, resourceID))
+		lags, err := r.waitForSync(ctx, resourceID, timeout)
+		if err != nil {
+			// The syncpoint is not saved, as it cannot be deleted to replace
+			// it. The next apply creates a new one.
+			resp.Diagnostics.AddError(
+				"Infinity System Syncpoint Not Reached",
+				fmt.Sprintf("Infinity system syncpoint %d was created, but %s", resourceID, err),
+			)
+			return
+		}
+		nodeLag := make(map[string]attr.Value, len(lags))
+		for name, lag := range lags {
+			nodeLag[name] = types.StringValue(lag.String())
+		}
+		model.NodeLag = types.MapValueMust(types.StringType, nodeLag)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
@@ -133,7 +200,7 @@ func (r *InfinitySystemSyncpointResource) read(ctx context.Context, resourceID i
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("system syncpoint with ID %d not found", resourceID)
+		return nil, notFoundErrorf("system syncpoint with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -152,6 +219,7 @@ func (r *InfinitySystemSyncpointResource) Read(ctx context.Context, req resource
 	}
 
 	resourceID := int(state.ResourceID.ValueInt32())
+	waitForSync, nodeLag, syncTimeouts := state.WaitForSync, state.NodeLag, state.Timeouts
 	state, err := r.read(ctx, resourceID)
 	if err != nil {
 		// Check if the error is a 404 (not found)
@@ -165,18 +233,40 @@ func (r *InfinitySystemSyncpointResource) Read(ctx context.Context, req resource
 		)
 		return
 	}
+	state.WaitForSync = waitForSync
+	state.NodeLag = nodeLag
+	state.Timeouts = syncTimeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *InfinitySystemSyncpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"System syncpoint resources cannot be updated. System syncpoints are immutable once created.",
-	)
+	if checkReadOnly(r.InfinityClient, "update Infinity system syncpoint", &resp.Diagnostics) {
+		return
+	}
+
+	plan := &InfinitySystemSyncpointResourceModel{}
+	state := &InfinitySystemSyncpointResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only wait_for_sync and the timeouts can change, which only apply to the
+	// creation of the syncpoint. The syncpoint itself is immutable.
+	state.WaitForSync = plan.WaitForSync
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *InfinitySystemSyncpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity system syncpoint", &resp.Diagnostics) {
+		return
+	}
+
 	resp.Diagnostics.AddError(
 		"Delete Not Supported",
 		"System syncpoint resources cannot be deleted. System syncpoints are permanent once created for system synchronization purposes.",
@@ -213,6 +303,72 @@ func (r *InfinitySystemSyncpointResource) ImportState(ctx context.Context, req r
 		return
 	}
 
+	model.WaitForSync = types.BoolValue(false)
+	model.NodeLag = types.MapNull(types.StringType)
+	model.Timeouts = timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})}
+
 	// Set the state from the imported resource
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
+
+// waitForSync polls the status API until every Conferencing Node has reached
+// the syncpoint. The status API does not say which syncpoint a node has
+// applied, so a node has reached it when it reports that it is in sync in a
+// status update after the syncpoint was created. It returns how long each node
+// took to reach the syncpoint. If they have not all reached it within timeout,
+// the error lists the nodes that lag behind.
+func (r *InfinitySystemSyncpointResource) waitForSync(ctx context.Context, resourceID int, timeout time.Duration) (map[string]time.Duration, error) {
+	pollInterval := r.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultSystemSyncpointPollInterval
+	}
+
+	syncpoint, err := r.InfinityClient.Config().GetSystemSyncpoint(ctx, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("its creation time could not be read: %w", err)
+	}
+	created := syncpoint.CreationTime.Time
+
+	lags := map[string]time.Duration{}
+	var behind []string
+	reached := -1
+	err = pollUntil(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		nodes, err := listWorkerVMStatuses(ctx, r.InfinityClient)
+		if err != nil {
+			return false, err
+		}
+
+		// Measure how far behind a node is on the Manager's clock, as the
+		// host running Terraform may not agree with it
+		latest := created
+		for _, node := range nodes {
+			if node.LastUpdated != nil && node.LastUpdated.After(latest) {
+				latest = node.LastUpdated.Time
+			}
+		}
+
+		behind = nil
+		for _, node := range nodes {
+			if _, ok := lags[node.Name]; ok {
+				continue
+			}
+			if node.SyncStatus == syncStatusSynced && node.LastUpdated != nil && !node.LastUpdated.Before(created) {
+				lags[node.Name] = node.LastUpdated.Sub(created).Round(time.Second)
+				continue
+			}
+			behind = append(behind, fmt.Sprintf("%s: sync_status %s, %s behind", node.Name, node.SyncStatus, latest.Sub(created).Round(time.Second)))
+		}
+		if done := len(nodes) - len(behind); done != reached {
+			reached = done
+			tflog.Info(ctx, fmt.Sprintf("%d of %d Conferencing Nodes have reached Infinity system syncpoint %d", done, len(nodes), resourceID))
+		}
+		return len(behind) == 0, nil
+	})
+	if errors.Is(err, errPollTimeout) && len(behind) > 0 {
+		return lags, fmt.Errorf("not every Conferencing Node reached it within %s:\n- %s", timeout, strings.Join(behind, "\n- "))
+	}
+	if err != nil {
+		return lags, fmt.Errorf("the Conferencing Nodes could not be read: %w", err)
+	}
+	return lags, nil
+}
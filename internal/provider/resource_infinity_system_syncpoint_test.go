@@ -12,9 +12,11 @@ import (
 	"time"
 
 	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/status"
 	"github.com/pexip/go-infinity-sdk/v38/types"
 	"github.com/pexip/go-infinity-sdk/v38/util"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/pexip/go-infinity-sdk/v38"
@@ -70,3 +72,65 @@ func testInfinitySystemSyncpoint(t *testing.T, client InfinityClient) {
 		},
 	})
 }
+
+func TestInfinitySystemSyncpointResource_waitForSync(t *testing.T) {
+	// Nodes that have not reached the syncpoint are reported as behind by the
+	// time between its creation and the newest status update, so the clock of
+	// the host running the tests does not matter
+	created := time.Now().Add(-time.Hour)
+	at := func(d time.Duration) *util.InfinityTime {
+		return &util.InfinityTime{Time: created.Add(d)}
+	}
+	node := func(name, syncStatus string, lastUpdated *util.InfinityTime) status.WorkerVM {
+		return status.WorkerVM{Name: name, SyncStatus: syncStatus, LastUpdated: lastUpdated}
+	}
+
+	tests := []struct {
+		name     string
+		statuses [][]status.WorkerVM
+		wantLags map[string]time.Duration
+		wantErr  string
+	}{
+		{
+			name: "reached",
+			statuses: [][]status.WorkerVM{
+				{node("worker-1", "SYNCED", at(-time.Minute)), node("worker-2", "SYNCING", at(2*time.Second))},
+				{node("worker-1", "SYNCED", at(4*time.Second)), node("worker-2", "SYNCING", at(5*time.Second))},
+				{node("worker-1", "SYNCED", at(9*time.Second)), node("worker-2", "SYNCED", at(7*time.Second))},
+			},
+			wantLags: map[string]time.Duration{"worker-1": 4 * time.Second, "worker-2": 7 * time.Second},
+		},
+		{
+			name:     "no nodes",
+			statuses: [][]status.WorkerVM{{}},
+			wantLags: map[string]time.Duration{},
+		},
+		{
+			name: "node behind",
+			statuses: [][]status.WorkerVM{
+				{node("worker-1", "SYNCED", at(3*time.Second)), node("worker-2", "SYNC_FAILED", at(time.Second)), node("worker-3", "SYNCED", nil)},
+			},
+			wantErr: "not every Conferencing Node reached it within 50ms:\n- worker-2: sync_status SYNC_FAILED, 3s behind\n- worker-3: sync_status SYNCED, 3s behind",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := infinity.NewClientMock()
+			client.On("GetJSON", mock.Anything, "configuration/v1/system_syncpoint/3/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				syncpoint := args.Get(3).(*config.SystemSyncpoint)
+				syncpoint.CreationTime = util.InfinityTime{Time: created}
+			})
+			mockWorkerVMReadiness(client, tt.statuses...)
+			r := &InfinitySystemSyncpointResource{InfinityClient: client, pollInterval: time.Millisecond}
+
+			lags, err := r.waitForSync(t.Context(), 3, 50*time.Millisecond)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantLags, lags)
+		})
+	}
+}
@@ -96,6 +96,10 @@ func (r *InfinitySystemTuneableResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinitySystemTuneableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity system tuneable", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySystemTuneableResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -110,7 +114,7 @@ func (r *InfinitySystemTuneableResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateSystemTuneable(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity system tuneable",
 			fmt.Sprintf("Could not create Infinity system tuneable: %s", err),
 		)
@@ -149,7 +153,7 @@ func (r *InfinitySystemTuneableResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("system tuneable with ID %d not found", resourceID)
+		return nil, notFoundErrorf("system tuneable with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -187,6 +191,10 @@ func (r *InfinitySystemTuneableResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinitySystemTuneableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity system tuneable", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinitySystemTuneableResourceModel{}
 	state := &InfinitySystemTuneableResourceModel{}
 
@@ -205,7 +213,7 @@ func (r *InfinitySystemTuneableResource) Update(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateSystemTuneable(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity system tuneable",
 			fmt.Sprintf("Could not update Infinity system tuneable with ID %d: %s", resourceID, err),
 		)
@@ -226,6 +234,10 @@ func (r *InfinitySystemTuneableResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinitySystemTuneableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity system tuneable", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinitySystemTuneableResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity system tuneable")
@@ -237,8 +249,8 @@ func (r *InfinitySystemTuneableResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteSystemTuneable(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity system tuneable",
 			fmt.Sprintf("Could not delete Infinity system tuneable with ID %s: %s", state.ID.ValueString(), err),
@@ -158,6 +158,10 @@ func (r *InfinityTeamsProxyResource) Schema(ctx context.Context, req resource.Sc
 }
 
 func (r *InfinityTeamsProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity Teams proxy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityTeamsProxyResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -187,7 +191,7 @@ func (r *InfinityTeamsProxyResource) Create(ctx context.Context, req resource.Cr
 
 	createResponse, err := r.InfinityClient.Config().CreateTeamsProxy(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity Teams proxy",
 			fmt.Sprintf("Could not create Infinity Teams proxy: %s", err),
 		)
@@ -226,7 +230,7 @@ func (r *InfinityTeamsProxyResource) read(ctx context.Context, resourceID int, n
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("teams proxy with ID %d not found", resourceID)
+		return nil, notFoundErrorf("teams proxy with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -271,6 +275,10 @@ func (r *InfinityTeamsProxyResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *InfinityTeamsProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity Teams proxy", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityTeamsProxyResourceModel{}
 	state := &InfinityTeamsProxyResourceModel{}
 
@@ -303,7 +311,7 @@ func (r *InfinityTeamsProxyResource) Update(ctx context.Context, req resource.Up
 
 	_, err := r.InfinityClient.Config().UpdateTeamsProxy(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity Teams proxy",
 			fmt.Sprintf("Could not update Infinity Teams proxy with ID %d: %s", resourceID, err),
 		)
@@ -324,6 +332,10 @@ func (r *InfinityTeamsProxyResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *InfinityTeamsProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity Teams proxy", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityTeamsProxyResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity Teams proxy")
@@ -335,8 +347,8 @@ func (r *InfinityTeamsProxyResource) Delete(ctx context.Context, req resource.De
 
 	err := r.InfinityClient.Config().DeleteTeamsProxy(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity Teams proxy",
 			fmt.Sprintf("Could not delete Infinity Teams proxy with ID %s: %s", state.ID.ValueString(), err),
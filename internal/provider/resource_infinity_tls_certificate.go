@@ -189,6 +189,10 @@ func (r *InfinityTLSCertificateResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityTLSCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity TLS certificate", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityTLSCertificateResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -216,7 +220,7 @@ func (r *InfinityTLSCertificateResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateTLSCertificate(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity TLS certificate",
 			fmt.Sprintf("Could not create Infinity TLS certificate: %s", err),
 		)
@@ -255,7 +259,7 @@ func (r *InfinityTLSCertificateResource) read(ctx context.Context, resourceID in
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("TLS certificate with ID %d not found", resourceID)
+		return nil, notFoundErrorf("TLS certificate with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -321,6 +325,10 @@ func (r *InfinityTLSCertificateResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityTLSCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity TLS certificate", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityTLSCertificateResourceModel{}
 	state := &InfinityTLSCertificateResourceModel{}
 
@@ -351,7 +359,7 @@ func (r *InfinityTLSCertificateResource) Update(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateTLSCertificate(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity TLS certificate",
 			fmt.Sprintf("Could not update Infinity TLS certificate with ID %d: %s", resourceID, err),
 		)
@@ -372,6 +380,10 @@ func (r *InfinityTLSCertificateResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityTLSCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity TLS certificate", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityTLSCertificateResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity TLS certificate")
@@ -383,8 +395,8 @@ func (r *InfinityTLSCertificateResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteTLSCertificate(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity TLS certificate",
 			fmt.Sprintf("Could not delete Infinity TLS certificate with ID %s: %s", state.ID.ValueString(), err),
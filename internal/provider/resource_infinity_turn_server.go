@@ -172,6 +172,10 @@ func (r *InfinityTURNServerResource) Schema(ctx context.Context, req resource.Sc
 }
 
 func (r *InfinityTURNServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity TURN server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityTURNServerResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -206,7 +210,7 @@ func (r *InfinityTURNServerResource) Create(ctx context.Context, req resource.Cr
 
 	createResponse, err := r.InfinityClient.Config().CreateTURNServer(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity TURN server",
 			fmt.Sprintf("Could not create Infinity TURN server: %s", err),
 		)
@@ -245,7 +249,7 @@ func (r *InfinityTURNServerResource) read(ctx context.Context, resourceID int, p
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("TURN server with ID %d not found", resourceID)
+		return nil, notFoundErrorf("TURN server with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -295,6 +299,10 @@ func (r *InfinityTURNServerResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *InfinityTURNServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity TURN server", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityTURNServerResourceModel{}
 	state := &InfinityTURNServerResourceModel{}
 
@@ -332,7 +340,7 @@ func (r *InfinityTURNServerResource) Update(ctx context.Context, req resource.Up
 
 	_, err := r.InfinityClient.Config().UpdateTURNServer(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity TURN server",
 			fmt.Sprintf("Could not update Infinity TURN server with ID %d: %s", resourceID, err),
 		)
@@ -353,6 +361,10 @@ func (r *InfinityTURNServerResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *InfinityTURNServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity TURN server", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityTURNServerResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity TURN server")
@@ -364,8 +376,8 @@ func (r *InfinityTURNServerResource) Delete(ctx context.Context, req resource.De
 
 	err := r.InfinityClient.Config().DeleteTURNServer(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity TURN server",
 			fmt.Sprintf("Could not delete Infinity TURN server with ID %s: %s", state.ID.ValueString(), err),
@@ -8,30 +8,78 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pexip/go-infinity-sdk/v38/command"
 	"github.com/pexip/go-infinity-sdk/v38/config"
 )
 
 var (
-	_ resource.Resource = (*InfinityUpgradeResource)(nil)
+	_ resource.Resource               = (*InfinityUpgradeResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*InfinityUpgradeResource)(nil)
+)
+
+const (
+	defaultUpgradeTimeout      = 2 * time.Hour
+	defaultUpgradePollInterval = 30 * time.Second
+	// upgradePackageEndpoint takes an upgrade package and starts the upgrade
+	upgradePackageEndpoint = "command/v1/platform/upgrade/"
 )
 
 type InfinityUpgradeResource struct {
 	InfinityClient InfinityClient
+
+	files        *fileClient
+	pollInterval time.Duration
 }
 
 type InfinityUpgradeResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Package   types.String `tfsdk:"package"`
-	Timestamp types.String `tfsdk:"timestamp"`
+	ID             types.String   `tfsdk:"id"`
+	Package        types.String   `tfsdk:"package"`
+	Source         types.String   `tfsdk:"source"`
+	SHA256         types.String   `tfsdk:"sha256"`
+	SourceSHA256   types.String   `tfsdk:"source_sha256"`
+	TargetVersion  types.String   `tfsdk:"target_version"`
+	Timestamp      types.String   `tfsdk:"timestamp"`
+	ManagerVersion types.String   `tfsdk:"manager_version"`
+	NodeVersions   types.Map      `tfsdk:"node_versions"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// upgradeNode is the version of a Management Node or Conferencing Node.
+type upgradeNode struct {
+	name          string
+	manager       bool
+	version       string
+	upgradeStatus string
+}
+
+func (n upgradeNode) String() string {
+	s := fmt.Sprintf("%s runs version %s", n.name, n.version)
+	if n.upgradeStatus != "" {
+		s += fmt.Sprintf(" (upgrade status %s)", n.upgradeStatus)
+	}
+	return s
 }
 
 func (r *InfinityUpgradeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +101,7 @@ func (r *InfinityUpgradeResource) Configure(ctx context.Context, req resource.Co
 	}
 
 	r.InfinityClient = p.client
+	r.files = p.files
 }
 
 func (r *InfinityUpgradeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -69,16 +118,67 @@ func (r *InfinityUpgradeResource) Schema(ctx context.Context, req resource.Schem
 				Optional:            true,
 				MarkdownDescription: "Specific upgrade package to use. If not specified, the system will use the default upgrade package.",
 			},
+			"source": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("package")),
+					stringvalidator.AlsoRequires(path.MatchRoot("sha256")),
+				},
+				MarkdownDescription: "Local path of an upgrade package (`.tar`) to upload to the Manager, which then starts the upgrade. The file is streamed to the Manager, not read into memory. Conflicts with `package`.",
+			},
+			"sha256": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be a SHA-256 checksum of 64 hexadecimal digits"),
+					stringvalidator.AlsoRequires(path.MatchRoot("source")),
+				},
+				MarkdownDescription: "The SHA-256 checksum of the file at `source`, as published with the release. The file is checked against it before it is uploaded. It is kept in state, so a new package, with a new checksum, triggers a new upload.",
+			},
+			"source_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 checksum of the file at `source`. It is recomputed on every plan, so a different file at the same path triggers a new upload even if `sha256` is unchanged.",
+			},
+			"target_version": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The version the upgrade installs, e.g. `38.0`. If set, creating the resource waits until the Manager and every Conferencing Node run this version, for as long as `timeouts.create`. A node also matches if its version starts with `target_version` followed by a dot or a space.",
+			},
 			"timestamp": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Timestamp when the upgrade was triggered",
 			},
+			"manager_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version the primary Management Node runs.",
+			},
+			"node_versions": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The version each Management Node and Conferencing Node runs, by node name.",
+			},
 		},
-		MarkdownDescription: "Triggers a system upgrade on the Infinity service. This is an action resource that initiates an upgrade process. Note: This resource only supports creation and reading - upgrades cannot be updated or undone once initiated. The resource represents the upgrade trigger action, not the upgrade state itself.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+		MarkdownDescription: "Triggers a system upgrade on the Infinity service. This is an action resource that initiates an upgrade process. Note: This resource only supports creation and reading - upgrades cannot be updated or undone once initiated. If `target_version` is set, creating the resource waits for the upgrade to be rolled out to every node, so that configuration that depends on the new version can be applied after it.",
 	}
 }
 
 func (r *InfinityUpgradeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity upgrade", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityUpgradeResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -86,21 +186,51 @@ func (r *InfinityUpgradeResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	createRequest := &config.UpgradeCreateRequest{}
-
-	// Handle optional package field
-	if !plan.Package.IsNull() && !plan.Package.IsUnknown() {
-		pkg := plan.Package.ValueString()
-		createRequest.Package = &pkg
+	// The upgrade status a node reports before the trigger may be left over
+	// from an earlier upgrade, so it is compared with the status afterwards
+	var before []upgradeNode
+	if !plan.TargetVersion.IsNull() {
+		var err error
+		if before, err = r.listNodes(ctx); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Could not read the node versions before triggering the upgrade: %s", err))
+		}
 	}
 
-	createResponse, err := r.InfinityClient.Config().CreateUpgrade(ctx, createRequest)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Triggering Infinity upgrade",
-			fmt.Sprintf("Could not trigger Infinity upgrade: %s", err),
-		)
-		return
+	if !plan.Source.IsNull() {
+		if r.files == nil {
+			resp.Diagnostics.AddError(
+				"File Transfers Not Configured",
+				"The provider has no client for file transfers. Please report this issue to the provider developers",
+			)
+			return
+		}
+		if err := r.uploadPackage(ctx, plan.Source.ValueString(), plan.SHA256.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Uploading Infinity upgrade package",
+				fmt.Sprintf("Could not upload upgrade package %s: %s", plan.Source.ValueString(), err),
+			)
+			return
+		}
+	} else {
+		createRequest := &config.UpgradeCreateRequest{}
+
+		// Handle optional package field
+		if !plan.Package.IsNull() && !plan.Package.IsUnknown() {
+			pkg := plan.Package.ValueString()
+			createRequest.Package = &pkg
+		}
+
+		createResponse, err := r.InfinityClient.Config().CreateUpgrade(ctx, createRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Triggering Infinity upgrade",
+				fmt.Sprintf("Could not trigger Infinity upgrade: %s", err),
+			)
+			return
+		}
+		if createResponse != nil {
+			tflog.Trace(ctx, fmt.Sprintf("upgrade response: %+v", createResponse))
+		}
 	}
 
 	// Generate a unique ID for this upgrade trigger
@@ -109,10 +239,19 @@ func (r *InfinityUpgradeResource) Create(ctx context.Context, req resource.Creat
 
 	// Since this is an action resource, we create a simple state representation
 	model := &InfinityUpgradeResourceModel{
-		ID:        types.StringValue(upgradeID),
-		Timestamp: types.StringValue(timestamp.Format(time.RFC3339)),
+		ID:            types.StringValue(upgradeID),
+		Source:        plan.Source,
+		SHA256:        plan.SHA256,
+		SourceSHA256:  types.StringNull(),
+		TargetVersion: plan.TargetVersion,
+		Timestamp:     types.StringValue(timestamp.Format(time.RFC3339)),
+		Timeouts:      plan.Timeouts,
 	}
 
+	if !plan.Source.IsNull() {
+		// uploadPackage checked that the file has this checksum
+		model.SourceSHA256 = types.StringValue(strings.ToLower(plan.SHA256.ValueString()))
+	}
 	if !plan.Package.IsNull() {
 		model.Package = plan.Package
 	} else {
@@ -121,11 +260,35 @@ func (r *InfinityUpgradeResource) Create(ctx context.Context, req resource.Creat
 
 	// Log the upgrade trigger
 	tflog.Info(ctx, fmt.Sprintf("triggered Infinity upgrade with ID: %s", upgradeID))
-	if createResponse != nil {
-		tflog.Trace(ctx, fmt.Sprintf("upgrade response: %+v", createResponse))
+
+	if plan.TargetVersion.IsNull() {
+		// Nothing to wait for. The Manager may already be restarting, so the
+		// versions are only recorded if they can be read.
+		nodes, err := r.listNodes(ctx)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Could not read the node versions after triggering the upgrade: %s", err))
+		}
+		resp.Diagnostics.Append(setNodeVersions(ctx, model, nodes)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, defaultUpgradeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	target := plan.TargetVersion.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("Waiting up to %s for every node to run version %s", timeout, target))
+	nodes, err := r.waitForUpgrade(ctx, target, before, timeout)
+	resp.Diagnostics.Append(setNodeVersions(ctx, model, nodes)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Infinity Upgrade Not Complete",
+			fmt.Sprintf("The upgrade to version %s was triggered, but %s", target, err),
+		)
+	}
 }
 
 func (r *InfinityUpgradeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -136,19 +299,53 @@ func (r *InfinityUpgradeResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	// For an action resource like upgrade, we just maintain the state as-is
-	// The upgrade may have completed, but the trigger record remains valid
+	// For an action resource like upgrade, the trigger record remains valid
+	// once the upgrade has completed. Only the versions of the nodes are read.
+	// They are informational, so if the status API cannot be read, e.g. while
+	// the Manager restarts, the versions from the previous state are kept.
+	nodes, err := r.listNodes(ctx)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not read the node versions, keeping the versions in state: %s", err))
+	} else {
+		resp.Diagnostics.Append(setNodeVersions(ctx, state, nodes)...)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *InfinityUpgradeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Upgrade resources cannot be updated. To trigger a new upgrade, delete this resource and create a new one.",
-	)
+	if checkReadOnly(r.InfinityClient, "update Infinity upgrade", &resp.Diagnostics) {
+		return
+	}
+
+	plan := &InfinityUpgradeResourceModel{}
+	state := &InfinityUpgradeResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Package.Equal(state.Package) {
+		resp.Diagnostics.AddError(
+			"Update Not Supported",
+			"Upgrade resources cannot be updated. To trigger a new upgrade, delete this resource and create a new one.",
+		)
+		return
+	}
+	// Changing the timeouts does not trigger anything. The checksum of the file
+	// is only new here if the state predates source_sha256.
+	state.Timeouts = plan.Timeouts
+	state.SourceSHA256 = plan.SourceSHA256
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *InfinityUpgradeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity upgrade", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityUpgradeResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity upgrade resource")
@@ -162,3 +359,226 @@ func (r *InfinityUpgradeResource) Delete(ctx context.Context, req resource.Delet
 	// The actual upgrade cannot be undone, but we can remove the trigger record
 	tflog.Info(ctx, fmt.Sprintf("removed upgrade trigger record with ID: %s", state.ID.ValueString()))
 }
+
+// ModifyPlan hashes the file at source on every plan, so that replacing the
+// file, e.g. with a rebuilt package, triggers a new upload. sha256 is still
+// what the file is checked against before it is uploaded.
+func (r *InfinityUpgradeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to hash when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan InfinityUpgradeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var state *InfinityUpgradeResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &InfinityUpgradeResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checksum := types.StringUnknown()
+	switch {
+	case plan.Source.IsNull():
+		checksum = types.StringNull()
+	case plan.Source.IsUnknown():
+	default:
+		sum, err := fileSHA256(plan.Source.ValueString())
+		if err == nil {
+			checksum = types.StringValue(sum)
+		} else if state != nil && plan.Source.Equal(state.Source) {
+			// The package may have been removed after the upgrade
+			tflog.Debug(ctx, fmt.Sprintf("Could not hash upgrade package %s, keeping its checksum in state: %s", plan.Source.ValueString(), err))
+			checksum = state.SourceSHA256
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), checksum)...)
+
+	if state != nil && !state.SourceSHA256.IsNull() && !checksum.IsUnknown() && !checksum.Equal(state.SourceSHA256) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_sha256"))
+	}
+}
+
+// fileSHA256 returns the hex encoded SHA-256 checksum of the named file.
+func fileSHA256(name string) (string, error) {
+	file, err := os.Open(name) // #nosec G304 -- File path provided by user in Terraform configuration
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// uploadPackage checks that the upgrade package at source has the SHA-256
+// checksum and streams it to the Manager, which then starts the upgrade.
+func (r *InfinityUpgradeResource) uploadPackage(ctx context.Context, source, checksum string) error {
+	file, err := os.Open(source) // #nosec G304 -- File path provided by user in Terraform configuration
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return fmt.Errorf("could not read the package: %w", err)
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(sum, checksum) {
+		return fmt.Errorf("its SHA-256 checksum is %s, not %s. The package was not uploaded", sum, strings.ToLower(checksum))
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("could not read the package: %w", err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Uploading Infinity upgrade package %s (%d bytes)", source, size))
+	var result command.CommandResponse
+	if err := r.files.upload(ctx, upgradePackageEndpoint, nil, "package", filepath.Base(source), file, &result); err != nil {
+		return err
+	}
+	if result.Status != "" && !strings.EqualFold(result.Status, "success") {
+		return fmt.Errorf("%s: %s", result.Status, result.Message)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Uploaded Infinity upgrade package %s", source))
+	return nil
+}
+
+// waitForUpgrade polls the status API until the Manager and every Conferencing
+// Node run the target version. It returns the last versions it has read. The
+// status API is not available while the Manager restarts, so errors reading
+// it are retried until timeout. It fails straight away if the upgrade of a
+// node has failed, and after timeout with the nodes that are stuck.
+//
+// A failed upgrade status may be left over from an earlier upgrade, so it only
+// counts once the node has started this upgrade, i.e. its version or upgrade
+// status differs from before, the nodes read before the trigger. Nodes that
+// are not in before are compared with the first time they are read.
+func (r *InfinityUpgradeResource) waitForUpgrade(ctx context.Context, target string, before []upgradeNode, timeout time.Duration) ([]upgradeNode, error) {
+	pollInterval := r.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultUpgradePollInterval
+	}
+
+	initial := make(map[string]upgradeNode, len(before))
+	for _, node := range before {
+		initial[node.name] = node
+	}
+	started := map[string]bool{}
+
+	var nodes, pending []upgradeNode
+	var lastErr error
+	upgraded := -1
+	err := pollUntil(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		current, err := r.listNodes(ctx)
+		if err != nil {
+			lastErr = err
+			tflog.Debug(ctx, fmt.Sprintf("Could not read the node versions: %s", err))
+			return false, nil
+		}
+		nodes, pending, lastErr = current, nil, nil
+		for _, node := range nodes {
+			previous, ok := initial[node.name]
+			if !ok {
+				initial[node.name] = node
+			} else if node.version != previous.version || node.upgradeStatus != previous.upgradeStatus {
+				started[node.name] = true
+			}
+			if started[node.name] && requestFailed(node.upgradeStatus) {
+				return false, fmt.Errorf("the upgrade failed: %s", node)
+			}
+			if !versionMatches(node.version, target) {
+				pending = append(pending, node)
+			}
+		}
+		if done := len(nodes) - len(pending); done != upgraded {
+			upgraded = done
+			tflog.Info(ctx, fmt.Sprintf("%d of %d nodes run version %s", done, len(nodes), target))
+		}
+		return len(nodes) > 0 && len(pending) == 0, nil
+	})
+	if !errors.Is(err, errPollTimeout) {
+		return nodes, err
+	}
+
+	if len(pending) == 0 {
+		message := fmt.Sprintf("the node versions could not be read within %s", timeout)
+		if lastErr != nil {
+			message += fmt.Sprintf(". Last error: %s", lastErr)
+		}
+		return nodes, errors.New(message)
+	}
+	stuck := make([]string, len(pending))
+	for i, node := range pending {
+		stuck[i] = node.String()
+	}
+	return nodes, fmt.Errorf("not every node runs it after %s:\n- %s", timeout, strings.Join(stuck, "\n- "))
+}
+
+// listNodes returns the versions of the Management Nodes and Conferencing
+// Nodes, starting with the primary Management Node.
+func (r *InfinityUpgradeResource) listNodes(ctx context.Context) ([]upgradeNode, error) {
+	managementVMs, err := listManagementVMStatuses(ctx, r.InfinityClient)
+	if err != nil {
+		return nil, err
+	}
+	workerVMs, err := listWorkerVMStatuses(ctx, r.InfinityClient)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]upgradeNode, 0, len(managementVMs)+len(workerVMs))
+	for _, vm := range managementVMs {
+		node := upgradeNode{name: vm.Name, manager: vm.Primary, version: vm.Version, upgradeStatus: vm.UpgradeStatus}
+		if vm.Primary {
+			nodes = append([]upgradeNode{node}, nodes...)
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	if len(nodes) > 0 && !nodes[0].manager {
+		// No Management Node is marked as the primary one
+		nodes[0].manager = true
+	}
+	for _, vm := range workerVMs {
+		nodes = append(nodes, upgradeNode{name: vm.Name, version: vm.Version, upgradeStatus: vm.UpgradeStatus})
+	}
+	return nodes, nil
+}
+
+// setNodeVersions sets the computed versions of the model from nodes. If
+// there are no nodes, the versions are null.
+func setNodeVersions(ctx context.Context, model *InfinityUpgradeResourceModel, nodes []upgradeNode) diag.Diagnostics {
+	model.ManagerVersion = types.StringNull()
+	if len(nodes) == 0 {
+		model.NodeVersions = types.MapNull(types.StringType)
+		return nil
+	}
+
+	versions := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		versions[node.name] = node.version
+		if node.manager {
+			model.ManagerVersion = types.StringValue(node.version)
+		}
+	}
+	var diags diag.Diagnostics
+	model.NodeVersions, diags = types.MapValueFrom(ctx, types.StringType, versions)
+	return diags
+}
+
+// versionMatches reports whether version is the target version, or a more
+// specific version of it, e.g. 38.0 or 38.0 (build 12345) for 38.0.
+func versionMatches(version, target string) bool {
+	if version == target {
+		return true
+	}
+	rest, ok := strings.CutPrefix(version, target)
+	return ok && target != "" && (strings.HasPrefix(rest, ".") || strings.HasPrefix(rest, " "))
+}
@@ -7,11 +7,29 @@
 package provider
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pexip/go-infinity-sdk/v38/command"
+	"github.com/pexip/go-infinity-sdk/v38/status"
 	"github.com/pexip/go-infinity-sdk/v38/types"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/pexip/go-infinity-sdk/v38"
@@ -33,7 +51,12 @@ func TestInfinityUpgrade(t *testing.T) {
 	}
 	client.On("PostWithResponse", mock.Anything, "configuration/v1/upgrade/", mock.Anything, mock.Anything).Return(createResponse, nil)
 
-	// Note: Upgrade is an action resource that doesn't have persistent state to read
+	// Note: Upgrade is an action resource that doesn't have persistent state
+	// to read, only the versions of the nodes
+	mockUpgradeStatus(client, upgradeStatus{
+		managers: []status.ManagementVM{{Name: "manager", Primary: true, Version: "38.0"}},
+		workers:  []status.WorkerVM{{Name: "worker-1", Version: "38.0"}},
+	})
 
 	testInfinityUpgrade(t, client)
 }
@@ -48,8 +71,340 @@ func testInfinityUpgrade(t *testing.T, client InfinityClient) {
 					resource.TestCheckResourceAttrSet("pexip_infinity_upgrade.upgrade-test", "id"),
 					resource.TestCheckResourceAttr("pexip_infinity_upgrade.upgrade-test", "package", "test-value"),
 					resource.TestCheckResourceAttrSet("pexip_infinity_upgrade.upgrade-test", "timestamp"),
+					resource.TestCheckResourceAttr("pexip_infinity_upgrade.upgrade-test", "manager_version", "38.0"),
+					resource.TestCheckResourceAttr("pexip_infinity_upgrade.upgrade-test", "node_versions.%", "2"),
+					resource.TestCheckResourceAttr("pexip_infinity_upgrade.upgrade-test", "node_versions.worker-1", "38.0"),
 				),
 			},
 		},
 	})
 }
+
+// upgradeStatus is what the status API returns for the nodes at one point of
+// an upgrade. If err is set, the status API is not available.
+type upgradeStatus struct {
+	managers []status.ManagementVM
+	workers  []status.WorkerVM
+	err      error
+}
+
+// mockUpgradeStatus returns the statuses in order, one per request for the
+// Management Nodes, repeating the last one.
+func mockUpgradeStatus(client *infinity.ClientMock, statuses ...upgradeStatus) {
+	var mu sync.Mutex
+	var current upgradeStatus
+	for i, st := range statuses {
+		call := client.On("GetJSON", mock.Anything, "status/v1/management_vm/", mock.Anything, mock.Anything).Return(st.err).Run(func(args mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+			current = st
+			args.Get(3).(*status.ManagementVMListResponse).Objects = st.managers
+		})
+		if i < len(statuses)-1 {
+			call.Once()
+		} else {
+			call.Maybe()
+		}
+	}
+	client.On("GetJSON", mock.Anything, "status/v1/worker_vm/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		args.Get(3).(*status.WorkerVMListResponse).Objects = current.workers
+	}).Maybe()
+}
+
+func TestInfinityUpgradeResource_waitForUpgrade(t *testing.T) {
+	manager := func(version, upgradeStatus string) []status.ManagementVM {
+		return []status.ManagementVM{{Name: "manager", Primary: true, Version: version, UpgradeStatus: upgradeStatus}}
+	}
+	worker := func(name, version, upgradeStatus string) status.WorkerVM {
+		return status.WorkerVM{Name: name, Version: version, UpgradeStatus: upgradeStatus}
+	}
+
+	tests := []struct {
+		name string
+		// before are the nodes read before the upgrade was triggered
+		before       []upgradeNode
+		statuses     []upgradeStatus
+		wantErr      string
+		wantVersions map[string]string
+	}{
+		{
+			name: "complete",
+			statuses: []upgradeStatus{
+				{managers: manager("37.2", "IN_PROGRESS"), workers: []status.WorkerVM{worker("worker-1", "37.2", ""), worker("worker-2", "37.2", "")}},
+				{err: errors.New("503 Service Unavailable")},
+				{managers: manager("38.0 (build 12345)", ""), workers: []status.WorkerVM{worker("worker-1", "37.2", "IN_PROGRESS"), worker("worker-2", "38.0", "")}},
+				{managers: manager("38.0 (build 12345)", ""), workers: []status.WorkerVM{worker("worker-1", "38.0", ""), worker("worker-2", "38.0", "")}},
+			},
+			wantVersions: map[string]string{"manager": "38.0 (build 12345)", "worker-1": "38.0", "worker-2": "38.0"},
+		},
+		{
+			name:   "node failed",
+			before: []upgradeNode{{name: "manager", manager: true, version: "37.2"}, {name: "worker-1", version: "37.2"}, {name: "worker-2", version: "37.2"}},
+			statuses: []upgradeStatus{
+				{managers: manager("38.0", ""), workers: []status.WorkerVM{worker("worker-1", "37.2", "FAILED"), worker("worker-2", "38.0", "")}},
+			},
+			wantErr: "the upgrade failed: worker-1 runs version 37.2 (upgrade status FAILED)",
+		},
+		{
+			name: "node failed after first read",
+			statuses: []upgradeStatus{
+				{managers: manager("38.0", ""), workers: []status.WorkerVM{worker("worker-1", "37.2", "IN_PROGRESS"), worker("worker-2", "38.0", "")}},
+				{managers: manager("38.0", ""), workers: []status.WorkerVM{worker("worker-1", "37.2", "FAILED"), worker("worker-2", "38.0", "")}},
+			},
+			wantErr: "the upgrade failed: worker-1 runs version 37.2 (upgrade status FAILED)",
+		},
+		{
+			// A failed status from an earlier upgrade is not fatal
+			name:   "earlier upgrade failed",
+			before: []upgradeNode{{name: "manager", manager: true, version: "37.2"}, {name: "worker-1", version: "37.2", upgradeStatus: "FAILED"}},
+			statuses: []upgradeStatus{
+				{managers: manager("38.0", ""), workers: []status.WorkerVM{worker("worker-1", "37.2", "FAILED")}},
+				{managers: manager("38.0", ""), workers: []status.WorkerVM{worker("worker-1", "37.2", "IN_PROGRESS")}},
+				{managers: manager("38.0", ""), workers: []status.WorkerVM{worker("worker-1", "38.0", "")}},
+			},
+			wantVersions: map[string]string{"manager": "38.0", "worker-1": "38.0"},
+		},
+		{
+			name: "node stuck",
+			statuses: []upgradeStatus{
+				{managers: manager("38.0", ""), workers: []status.WorkerVM{worker("worker-1", "37.2", "IN_PROGRESS"), worker("worker-2", "38.0", ""), worker("worker-3", "37.2", "")}},
+			},
+			wantErr: "not every node runs it after 50ms:\n- worker-1 runs version 37.2 (upgrade status IN_PROGRESS)\n- worker-3 runs version 37.2",
+		},
+		{
+			name:     "manager down",
+			statuses: []upgradeStatus{{err: errors.New("503 Service Unavailable")}},
+			wantErr:  "the node versions could not be read within 50ms. Last error: 503 Service Unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := infinity.NewClientMock()
+			mockUpgradeStatus(client, tt.statuses...)
+			r := &InfinityUpgradeResource{InfinityClient: client, pollInterval: time.Millisecond}
+
+			nodes, err := r.waitForUpgrade(t.Context(), "38.0", tt.before, 50*time.Millisecond)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			versions := map[string]string{}
+			for _, node := range nodes {
+				versions[node.name] = node.version
+			}
+			require.Equal(t, tt.wantVersions, versions)
+		})
+	}
+}
+
+func TestVersionMatches(t *testing.T) {
+	for version, want := range map[string]bool{
+		"38.0":               true,
+		"38.0.1":             true,
+		"38.0 (build 12345)": true,
+		"38.00":              false,
+		"38.1":               false,
+		"37.2":               false,
+		"":                   false,
+	} {
+		require.Equal(t, want, versionMatches(version, "38.0"), version)
+	}
+	require.True(t, versionMatches("38.1", "38"))
+	require.False(t, versionMatches("38.0", ""))
+}
+
+func TestInfinityUpgradeResource_uploadPackage(t *testing.T) {
+	bundle := []byte("pexip-infinity-38.0 upgrade package")
+	sum := sha256.Sum256(bundle)
+	checksum := hex.EncodeToString(sum[:])
+	source := filepath.Join(t.TempDir(), "pexip-infinity-38.0.tar")
+	require.NoError(t, os.WriteFile(source, bundle, 0o600))
+
+	// newTestUpgradeResource returns a resource that uploads to a stand-in for
+	// the Manager, and the packages it received by filename
+	newTestUpgradeResource := func(t *testing.T, result string) (*InfinityUpgradeResource, map[string][]byte) {
+		var mu sync.Mutex
+		uploaded := map[string][]byte{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "/api/admin/command/v1/platform/upgrade/", r.URL.Path)
+			file, header, err := r.FormFile("package")
+			require.NoError(t, err)
+			defer file.Close()
+			uploaded[header.Filename], err = io.ReadAll(file)
+			require.NoError(t, err)
+			require.NoError(t, json.NewEncoder(w).Encode(command.CommandResponse{Status: result, Message: "Not enough disk space"}))
+		}))
+		t.Cleanup(server.Close)
+
+		files, err := newFileClient(server.URL, server.Client(), nil, "")
+		require.NoError(t, err)
+		return &InfinityUpgradeResource{files: files}, uploaded
+	}
+
+	t.Run("upload", func(t *testing.T) {
+		r, uploaded := newTestUpgradeResource(t, "success")
+
+		require.NoError(t, r.uploadPackage(t.Context(), source, strings.ToUpper(checksum)))
+		require.Equal(t, map[string][]byte{"pexip-infinity-38.0.tar": bundle}, uploaded)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		r, uploaded := newTestUpgradeResource(t, "success")
+		other := strings.Repeat("0", 64)
+
+		err := r.uploadPackage(t.Context(), source, other)
+		require.EqualError(t, err, "its SHA-256 checksum is "+checksum+", not "+other+". The package was not uploaded")
+		require.Empty(t, uploaded)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		r, _ := newTestUpgradeResource(t, "failure")
+
+		require.EqualError(t, r.uploadPackage(t.Context(), source, checksum), "failure: Not enough disk space")
+	})
+
+	t.Run("missing package", func(t *testing.T) {
+		r, uploaded := newTestUpgradeResource(t, "success")
+
+		require.ErrorIs(t, r.uploadPackage(t.Context(), filepath.Join(t.TempDir(), "missing.tar"), checksum), os.ErrNotExist)
+		require.Empty(t, uploaded)
+	})
+}
+
+func TestInfinityUpgradeResource_ModifyPlan(t *testing.T) {
+	bundle := []byte("pexip-infinity-38.0 upgrade package")
+	sum := sha256.Sum256(bundle)
+	checksum := hex.EncodeToString(sum[:])
+	source := filepath.Join(t.TempDir(), "pexip-infinity-38.0.tar")
+	require.NoError(t, os.WriteFile(source, bundle, 0o600))
+
+	r := &InfinityUpgradeResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(t.Context(), fwresource.SchemaRequest{}, schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	require.True(t, ok)
+
+	// upgrade returns the raw value of an upgrade from source, with
+	// source_sha256 set to sourceSHA256 unless it is nil
+	upgrade := func(sourceSHA256 any) tftypes.Value {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, typ := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		values["source"] = tftypes.NewValue(tftypes.String, source)
+		values["sha256"] = tftypes.NewValue(tftypes.String, checksum)
+		values["source_sha256"] = tftypes.NewValue(tftypes.String, sourceSHA256)
+		return tftypes.NewValue(objectType, values)
+	}
+	modifyPlan := func(t *testing.T, state tftypes.Value) (string, []fwpath.Path) {
+		plan := tfsdk.Plan{Raw: upgrade(tftypes.UnknownValue), Schema: schemaResp.Schema}
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(t.Context(), fwresource.ModifyPlanRequest{
+			Plan:  plan,
+			State: tfsdk.State{Raw: state, Schema: schemaResp.Schema},
+		}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var planned InfinityUpgradeResourceModel
+		require.False(t, resp.Plan.Get(t.Context(), &planned).HasError())
+		return planned.SourceSHA256.ValueString(), resp.RequiresReplace
+	}
+
+	t.Run("create", func(t *testing.T) {
+		planned, replace := modifyPlan(t, tftypes.NewValue(objectType, nil))
+		require.Equal(t, checksum, planned)
+		require.Empty(t, replace)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		planned, replace := modifyPlan(t, upgrade(checksum))
+		require.Equal(t, checksum, planned)
+		require.Empty(t, replace)
+	})
+
+	t.Run("file replaced", func(t *testing.T) {
+		previous := strings.Repeat("0", 64)
+		planned, replace := modifyPlan(t, upgrade(previous))
+		require.Equal(t, checksum, planned)
+		require.Equal(t, []fwpath.Path{fwpath.Root("source_sha256")}, replace)
+	})
+
+	t.Run("file removed", func(t *testing.T) {
+		previous := strings.Repeat("0", 64)
+		require.NoError(t, os.Remove(source))
+
+		planned, replace := modifyPlan(t, upgrade(previous))
+		require.Equal(t, previous, planned)
+		require.Empty(t, replace)
+	})
+}
+
+func TestInfinityUpgradeResource_Read(t *testing.T) {
+	r := &InfinityUpgradeResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(t.Context(), fwresource.SchemaRequest{}, schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	require.True(t, ok)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, "upgrade_1748768400")
+	values["manager_version"] = tftypes.NewValue(tftypes.String, "37.2")
+	values["node_versions"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"manager":  tftypes.NewValue(tftypes.String, "37.2"),
+		"worker-1": tftypes.NewValue(tftypes.String, "37.2"),
+	})
+	state := tfsdk.State{Raw: tftypes.NewValue(objectType, values), Schema: schemaResp.Schema}
+
+	tests := []struct {
+		name         string
+		statuses     []upgradeStatus
+		wantManager  string
+		wantVersions map[string]string
+	}{
+		{
+			name: "versions read",
+			statuses: []upgradeStatus{{
+				managers: []status.ManagementVM{{Name: "manager", Primary: true, Version: "38.0"}},
+				workers:  []status.WorkerVM{{Name: "worker-1", Version: "38.0"}},
+			}},
+			wantManager:  "38.0",
+			wantVersions: map[string]string{"manager": "38.0", "worker-1": "38.0"},
+		},
+		{
+			// The Manager may be restarting for the upgrade
+			name:         "status API unavailable",
+			statuses:     []upgradeStatus{{err: errors.New("503 Service Unavailable")}},
+			wantManager:  "37.2",
+			wantVersions: map[string]string{"manager": "37.2", "worker-1": "37.2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := infinity.NewClientMock()
+			mockUpgradeStatus(client, tt.statuses...)
+			r := &InfinityUpgradeResource{InfinityClient: client}
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(t.Context(), fwresource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var model InfinityUpgradeResourceModel
+			require.False(t, resp.State.Get(t.Context(), &model).HasError())
+			require.Equal(t, tt.wantManager, model.ManagerVersion.ValueString())
+			versions := map[string]string{}
+			require.False(t, model.NodeVersions.ElementsAs(t.Context(), &versions, false).HasError())
+			require.Equal(t, tt.wantVersions, versions)
+		})
+	}
+}
@@ -113,6 +113,10 @@ func (r *InfinityUserGroupResource) Schema(ctx context.Context, req resource.Sch
 }
 
 func (r *InfinityUserGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity user group", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityUserGroupResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -147,7 +151,7 @@ func (r *InfinityUserGroupResource) Create(ctx context.Context, req resource.Cre
 
 	createResponse, err := r.InfinityClient.Config().CreateUserGroup(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity user group",
 			fmt.Sprintf("Could not create Infinity user group: %s", err),
 		)
@@ -186,7 +190,7 @@ func (r *InfinityUserGroupResource) read(ctx context.Context, resourceID int) (*
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("user group with ID %d not found", resourceID)
+		return nil, notFoundErrorf("user group with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -244,6 +248,10 @@ func (r *InfinityUserGroupResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *InfinityUserGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity user group", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityUserGroupResourceModel{}
 	state := &InfinityUserGroupResourceModel{}
 
@@ -281,7 +289,7 @@ func (r *InfinityUserGroupResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.InfinityClient.Config().UpdateUserGroup(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity user group",
 			fmt.Sprintf("Could not update Infinity user group with ID %d: %s", resourceID, err),
 		)
@@ -302,6 +310,10 @@ func (r *InfinityUserGroupResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *InfinityUserGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity user group", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityUserGroupResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity user group")
@@ -313,8 +325,8 @@ func (r *InfinityUserGroupResource) Delete(ctx context.Context, req resource.Del
 
 	err := r.InfinityClient.Config().DeleteUserGroup(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity user group",
 			fmt.Sprintf("Could not delete Infinity user group with ID %s: %s", state.ID.ValueString(), err),
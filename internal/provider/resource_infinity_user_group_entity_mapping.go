@@ -107,6 +107,10 @@ func (r *InfinityUserGroupEntityMappingResource) Schema(ctx context.Context, req
 }
 
 func (r *InfinityUserGroupEntityMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity user group entity mapping", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityUserGroupEntityMappingResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -122,7 +126,7 @@ func (r *InfinityUserGroupEntityMappingResource) Create(ctx context.Context, req
 
 	createResponse, err := r.InfinityClient.Config().CreateUserGroupEntityMapping(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity user group entity mapping",
 			fmt.Sprintf("Could not create Infinity user group entity mapping: %s", err),
 		)
@@ -161,7 +165,7 @@ func (r *InfinityUserGroupEntityMappingResource) read(ctx context.Context, resou
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("user group entity mapping with ID %d not found", resourceID)
+		return nil, notFoundErrorf("user group entity mapping with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -200,6 +204,10 @@ func (r *InfinityUserGroupEntityMappingResource) Read(ctx context.Context, req r
 }
 
 func (r *InfinityUserGroupEntityMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity user group entity mapping", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityUserGroupEntityMappingResourceModel{}
 	state := &InfinityUserGroupEntityMappingResourceModel{}
 
@@ -219,7 +227,7 @@ func (r *InfinityUserGroupEntityMappingResource) Update(ctx context.Context, req
 
 	_, err := r.InfinityClient.Config().UpdateUserGroupEntityMapping(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity user group entity mapping",
 			fmt.Sprintf("Could not update Infinity user group entity mapping with ID %d: %s", resourceID, err),
 		)
@@ -240,6 +248,10 @@ func (r *InfinityUserGroupEntityMappingResource) Update(ctx context.Context, req
 }
 
 func (r *InfinityUserGroupEntityMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity user group entity mapping", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityUserGroupEntityMappingResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity user group entity mapping")
@@ -251,8 +263,8 @@ func (r *InfinityUserGroupEntityMappingResource) Delete(ctx context.Context, req
 
 	err := r.InfinityClient.Config().DeleteUserGroupEntityMapping(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity user group entity mapping",
 			fmt.Sprintf("Could not delete Infinity user group entity mapping with ID %s: %s", state.ID.ValueString(), err),
@@ -132,6 +132,10 @@ func (r *InfinityWebappAliasResource) Schema(ctx context.Context, req resource.S
 }
 
 func (r *InfinityWebappAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity webapp alias", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityWebappAliasResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -159,7 +163,7 @@ func (r *InfinityWebappAliasResource) Create(ctx context.Context, req resource.C
 
 	createResponse, err := r.InfinityClient.Config().CreateWebappAlias(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity webapp alias",
 			fmt.Sprintf("Could not create Infinity webapp alias: %s", err),
 		)
@@ -198,7 +202,7 @@ func (r *InfinityWebappAliasResource) read(ctx context.Context, resourceID int)
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("webapp alias with ID %d not found", resourceID)
+		return nil, notFoundErrorf("webapp alias with ID %d not found", resourceID)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -253,6 +257,10 @@ func (r *InfinityWebappAliasResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *InfinityWebappAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity webapp alias", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityWebappAliasResourceModel{}
 	state := &InfinityWebappAliasResourceModel{}
 
@@ -287,7 +295,7 @@ func (r *InfinityWebappAliasResource) Update(ctx context.Context, req resource.U
 	resourceID := int(state.ResourceID.ValueInt32())
 	_, err := r.InfinityClient.Config().UpdateWebappAlias(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity webapp alias",
 			fmt.Sprintf("Could not update Infinity webapp alias: %s", err),
 		)
@@ -308,6 +316,10 @@ func (r *InfinityWebappAliasResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *InfinityWebappAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity webapp alias", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityWebappAliasResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity webapp alias")
@@ -319,8 +331,8 @@ func (r *InfinityWebappAliasResource) Delete(ctx context.Context, req resource.D
 
 	err := r.InfinityClient.Config().DeleteWebappAlias(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity webapp alias",
 			fmt.Sprintf("Could not delete Infinity webapp alias with ID %s: %s", state.ID.ValueString(), err),
@@ -125,6 +125,10 @@ func (r *InfinityWebappBrandingResource) Schema(ctx context.Context, req resourc
 }
 
 func (r *InfinityWebappBrandingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity webapp branding", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityWebappBrandingResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -163,7 +167,7 @@ func (r *InfinityWebappBrandingResource) Create(ctx context.Context, req resourc
 
 	createResponse, err := r.InfinityClient.Config().CreateWebappBranding(ctx, createRequest, filename, brandingFile)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity webapp branding",
 			fmt.Sprintf("Could not create Infinity webapp branding: %s", err),
 		)
@@ -200,7 +204,7 @@ func (r *InfinityWebappBrandingResource) read(ctx context.Context, uuid string,
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("webapp branding with UUID '%s' not found", uuid)
+		return nil, notFoundErrorf("webapp branding with UUID '%s' not found", uuid)
 	}
 
 	data.ID = types.StringValue(srv.ResourceURI)
@@ -240,6 +244,10 @@ func (r *InfinityWebappBrandingResource) Read(ctx context.Context, req resource.
 }
 
 func (r *InfinityWebappBrandingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity webapp branding", &resp.Diagnostics) {
+		return
+	}
+
 	var plan, state InfinityWebappBrandingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -256,7 +264,7 @@ func (r *InfinityWebappBrandingResource) Update(ctx context.Context, req resourc
 
 	_, err := r.InfinityClient.Config().UpdateWebappBranding(ctx, state.UUID.ValueString(), updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity webapp branding",
 			fmt.Sprintf("Could not update Infinity webapp branding UUID '%s': %s", state.UUID.ValueString(), err),
 		)
@@ -277,6 +285,10 @@ func (r *InfinityWebappBrandingResource) Update(ctx context.Context, req resourc
 }
 
 func (r *InfinityWebappBrandingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity webapp branding", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityWebappBrandingResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity webapp branding")
@@ -288,8 +300,8 @@ func (r *InfinityWebappBrandingResource) Delete(ctx context.Context, req resourc
 
 	err := r.InfinityClient.Config().DeleteWebappBranding(ctx, state.UUID.ValueString())
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity webapp branding",
 			fmt.Sprintf("Could not delete Infinity webapp branding with UUID %s: %s", state.UUID.ValueString(), err),
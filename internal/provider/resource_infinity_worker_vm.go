@@ -8,11 +8,16 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/status"
 
 	"github.com/pexip/terraform-provider-pexip/internal/provider/validators"
 )
@@ -36,8 +42,19 @@ var (
 	_ resource.ResourceWithModifyPlan  = (*InfinityWorkerVMResource)(nil)
 )
 
+const (
+	defaultWorkerVMReadyTimeout = 30 * time.Minute
+	defaultWorkerVMDrainTimeout = "30m"
+	defaultWorkerVMPollInterval = 10 * time.Second
+	// defaultWorkerVMDrainReason is the maintenance mode reason of a node that
+	// is drained before it is deleted, unless it already has a reason.
+	defaultWorkerVMDrainReason = "Draining before deletion"
+)
+
 type InfinityWorkerVMResource struct {
 	InfinityClient InfinityClient
+
+	pollInterval time.Duration
 }
 
 type InfinityWorkerVMResourceModel struct {
@@ -82,6 +99,11 @@ type InfinityWorkerVMResourceModel struct {
 	TLSCertificate             types.String `tfsdk:"tls_certificate"`
 
 	Config types.String `tfsdk:"config"`
+
+	WaitForReady  types.Bool     `tfsdk:"wait_for_ready"`
+	DrainOnDelete types.Bool     `tfsdk:"drain_on_delete"`
+	DrainTimeout  types.String   `tfsdk:"drain_timeout"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *InfinityWorkerVMResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -423,6 +445,32 @@ func (r *InfinityWorkerVMResource) Schema(ctx context.Context, req resource.Sche
 				Sensitive:           true,
 				MarkdownDescription: "Bootstrap configuration for the Infinity Node.",
 			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to wait after creating the worker VM until the node has registered with the Manager, is in sync and runs the Manager's version. How long to wait is set with `timeouts.create`. Defaults to `false`.",
+			},
+			"drain_on_delete": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to drain the node before deleting the worker VM. The node is put in maintenance mode, so that it takes no new calls, and is deleted once it has no participants left or `drain_timeout` has passed. Defaults to `false`.",
+			},
+			"drain_timeout": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultWorkerVMDrainTimeout),
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+				MarkdownDescription: "How long to wait for the calls on the node to end when `drain_on_delete` is set, e.g. `2h`. The worker VM is deleted when it has passed, dropping any calls that are left. Defaults to `30m`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 		MarkdownDescription: "Manages a worker VM configuration with the Infinity service.",
 	}
@@ -458,6 +506,10 @@ func (r *InfinityWorkerVMResource) ModifyPlan(ctx context.Context, req resource.
 }
 
 func (r *InfinityWorkerVMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.InfinityClient, "create Infinity worker VM", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityWorkerVMResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -575,7 +627,7 @@ func (r *InfinityWorkerVMResource) Create(ctx context.Context, req resource.Crea
 
 	createResponse, err := r.InfinityClient.Config().CreateWorkerVM(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Creating Infinity worker VM",
 			fmt.Sprintf("Could not create Infinity worker VM: %s", err),
 		)
@@ -601,8 +653,28 @@ func (r *InfinityWorkerVMResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 	tflog.Trace(ctx, fmt.Sprintf("created Infinity worker VM with ID: %s, name: %s", model.ID, model.Name))
+	model.WaitForReady = plan.WaitForReady
+	model.DrainOnDelete = plan.DrainOnDelete
+	model.DrainTimeout = plan.DrainTimeout
+	model.Timeouts = plan.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() || !plan.WaitForReady.ValueBool() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultWorkerVMReadyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Waiting up to %s for Infinity worker VM %s to be ready", timeout, model.Name.ValueString()))
+	if err := r.waitForReady(ctx, model, timeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Infinity Worker VM Not Ready",
+			fmt.Sprintf("Infinity worker VM %s was created, but %s", model.Name.ValueString(), err),
+		)
+	}
 }
 
 func (r *InfinityWorkerVMResource) read(ctx context.Context, resourceID int, vmConfig, deployType, password, snmpAuthPass, snmpPrivPass string, vm_system_memory, vm_cpu_count int64) (*InfinityWorkerVMResourceModel, error) {
@@ -614,7 +686,7 @@ func (r *InfinityWorkerVMResource) read(ctx context.Context, resourceID int, vmC
 	}
 
 	if srv.ResourceURI == "" {
-		return nil, fmt.Errorf("worker VM with ID %d not found", resourceID)
+		return nil, notFoundErrorf("worker VM with ID %d not found", resourceID)
 	}
 
 	// Set required and default fields
@@ -698,6 +770,7 @@ func (r *InfinityWorkerVMResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	resourceID := int(state.ResourceID.ValueInt32())
+	waitForReady, drainOnDelete, drainTimeout, readyTimeouts := state.WaitForReady, state.DrainOnDelete, state.DrainTimeout, state.Timeouts
 	state, err := r.read(ctx, resourceID, state.Config.ValueString(), state.DeploymentType.ValueString(), state.Password.ValueString(), state.SNMPAuthenticationPassword.ValueString(), state.SNMPPrivacyPassword.ValueString(), state.VMSystemMemory.ValueInt64(), state.VMCPUCount.ValueInt64())
 	if err != nil {
 		// Check if the error is a 404 (not found)
@@ -711,11 +784,30 @@ func (r *InfinityWorkerVMResource) Read(ctx context.Context, req resource.ReadRe
 		)
 		return
 	}
+	// State written by provider versions without these attributes holds nulls.
+	// Read them as their defaults, or every existing VM would plan an update.
+	if waitForReady.IsNull() {
+		waitForReady = types.BoolValue(false)
+	}
+	if drainOnDelete.IsNull() {
+		drainOnDelete = types.BoolValue(false)
+	}
+	if drainTimeout.IsNull() {
+		drainTimeout = types.StringValue(defaultWorkerVMDrainTimeout)
+	}
+	state.WaitForReady = waitForReady
+	state.DrainOnDelete = drainOnDelete
+	state.DrainTimeout = drainTimeout
+	state.Timeouts = readyTimeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *InfinityWorkerVMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.InfinityClient, "update Infinity worker VM", &resp.Diagnostics) {
+		return
+	}
+
 	plan := &InfinityWorkerVMResourceModel{}
 	state := &InfinityWorkerVMResourceModel{}
 
@@ -803,7 +895,7 @@ func (r *InfinityWorkerVMResource) Update(ctx context.Context, req resource.Upda
 	// Send the update request to the management node
 	_, err := r.InfinityClient.Config().UpdateWorkerVM(ctx, resourceID, updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan, err,
 			"Error Updating Infinity worker VM",
 			fmt.Sprintf("Could not update Infinity worker VM with ID %d: %s", resourceID, err),
 		)
@@ -819,11 +911,19 @@ func (r *InfinityWorkerVMResource) Update(ctx context.Context, req resource.Upda
 		)
 		return
 	}
+	updatedModel.WaitForReady = plan.WaitForReady
+	updatedModel.DrainOnDelete = plan.DrainOnDelete
+	updatedModel.DrainTimeout = plan.DrainTimeout
+	updatedModel.Timeouts = plan.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, updatedModel)...)
 }
 
 func (r *InfinityWorkerVMResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.InfinityClient, "delete Infinity worker VM", &resp.Diagnostics) {
+		return
+	}
+
 	state := &InfinityWorkerVMResourceModel{}
 
 	tflog.Info(ctx, "Deleting Infinity worker VM")
@@ -833,10 +933,22 @@ func (r *InfinityWorkerVMResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	if state.DrainOnDelete.ValueBool() {
+		// The value has been checked by the validator
+		timeout, _ := time.ParseDuration(state.DrainTimeout.ValueString())
+		if err := r.drain(ctx, state, timeout); err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Draining Infinity worker VM",
+				fmt.Sprintf("Could not drain Infinity worker VM %s before deleting it: %s", state.Name.ValueString(), err),
+			)
+			return
+		}
+	}
+
 	err := r.InfinityClient.Config().DeleteWorkerVM(ctx, int(state.ResourceID.ValueInt32()))
 
-	// Ignore 404 Not Found and Lookup errors on delete
-	if err != nil && !isNotFoundError(err) && !isLookupError(err) {
+	// Ignore 404 Not Found errors on delete
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Infinity worker VM",
 			fmt.Sprintf("Could not delete Infinity worker VM with ID %s: %s", state.ID.ValueString(), err),
@@ -875,17 +987,153 @@ func (r *InfinityWorkerVMResource) ImportState(ctx context.Context, req resource
 		return
 	}
 
+	model.WaitForReady = types.BoolValue(false)
+	model.DrainOnDelete = types.BoolValue(false)
+	model.DrainTimeout = types.StringValue(defaultWorkerVMDrainTimeout)
+	model.Timeouts = timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})}
+
 	// Set the state from the imported resource
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
-// isNotFoundError checks if the error indicates a 404/not found response
-func isNotFoundError(err error) bool {
-	return strings.Contains(err.Error(), "404") ||
-		strings.Contains(err.Error(), "not found") ||
-		strings.Contains(err.Error(), "Not Found")
+// waitForReady polls the status API until the Conferencing Node has registered
+// with the Manager, is in sync and runs the Manager's version. If it is not
+// ready within timeout, the error says which of these it is missing, along
+// with the node's latest alarm.
+func (r *InfinityWorkerVMResource) waitForReady(ctx context.Context, vm *InfinityWorkerVMResourceModel, timeout time.Duration) error {
+	pollInterval := r.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultWorkerVMPollInterval
+	}
+	resourceID := int(vm.ResourceID.ValueInt32())
+
+	waiting := ""
+	err := pollUntil(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		version, err := managerVersion(ctx, r.InfinityClient)
+		if err != nil {
+			return false, err
+		}
+		nodes, err := listWorkerVMStatuses(ctx, r.InfinityClient)
+		if err != nil {
+			return false, err
+		}
+
+		i := slices.IndexFunc(nodes, func(node status.WorkerVM) bool { return node.ConfigurationID == resourceID })
+		switch {
+		case i < 0:
+			waiting = "it has not registered with the Manager"
+		case nodes[i].SyncStatus != syncStatusSynced:
+			waiting = fmt.Sprintf("its sync_status is %s", nodes[i].SyncStatus)
+		case nodes[i].Version != version:
+			waiting = fmt.Sprintf("it runs version %s, but the Manager runs %s", nodes[i].Version, version)
+		default:
+			return true, nil
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Infinity worker VM %s is not ready: %s", vm.Name.ValueString(), waiting))
+		return false, nil
+	})
+	if !errors.Is(err, errPollTimeout) {
+		return err
+	}
+
+	message := fmt.Sprintf("it was not ready within %s: %s", timeout, waiting)
+	if alarm := r.lastAlarm(ctx, vm); alarm != nil {
+		message += fmt.Sprintf(". Last error: %s: %s", alarm.Name, alarm.Details)
+	}
+	return errors.New(message)
+}
+
+// lastAlarm returns the latest active alarm raised for the Conferencing Node,
+// or nil if there is none or the alarms cannot be read.
+func (r *InfinityWorkerVMResource) lastAlarm(ctx context.Context, vm *InfinityWorkerVMResourceModel) *status.Alarm {
+	alarms, err := listAlarms(ctx, r.InfinityClient)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not read the alarms of Infinity worker VM %s: %s", vm.Name.ValueString(), err))
+		return nil
+	}
+
+	var last *status.Alarm
+	for i, alarm := range alarms {
+		if !isNode(vm, alarm.Node) {
+			continue
+		}
+		if last == nil || (alarm.TimeRaised != nil && (last.TimeRaised == nil || alarm.TimeRaised.After(last.TimeRaised.Time))) {
+			last = &alarms[i]
+		}
+	}
+	return last
+}
+
+// drain puts the Conferencing Node in maintenance mode, so that it takes no
+// new calls, and polls the status API until it has no participants left. If
+// there are still participants after timeout, it gives up and returns nil, so
+// that the node is deleted anyway.
+func (r *InfinityWorkerVMResource) drain(ctx context.Context, vm *InfinityWorkerVMResourceModel, timeout time.Duration) error {
+	pollInterval := r.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultWorkerVMPollInterval
+	}
+	reason := vm.MaintenanceModeReason.ValueString()
+	if reason == "" {
+		reason = defaultWorkerVMDrainReason
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Putting Infinity worker VM %s in maintenance mode: %s", vm.Name.ValueString(), reason))
+	// Use PatchJSON directly, as UpdateWorkerVM replaces the whole configuration
+	endpoint := fmt.Sprintf("configuration/v1/worker_vm/%d/", vm.ResourceID.ValueInt32())
+	maintenance := map[string]any{
+		"maintenance_mode":        true,
+		"maintenance_mode_reason": reason,
+	}
+	if err := r.InfinityClient.PatchJSON(ctx, endpoint, maintenance, nil); err != nil {
+		return fmt.Errorf("could not enable maintenance mode: %w", err)
+	}
+
+	participants := -1
+	err := pollUntil(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		all, err := listParticipantStatuses(ctx, r.InfinityClient, nil)
+		if err != nil {
+			return false, fmt.Errorf("could not list participants: %w", err)
+		}
+		count := 0
+		for _, participant := range all {
+			if isNode(vm, participant.MediaNode) || isNode(vm, participant.SignallingNode) || isNode(vm, participant.ProxyNode) {
+				count++
+			}
+		}
+		if count != participants {
+			participants = count
+			tflog.Info(ctx, fmt.Sprintf("Draining Infinity worker VM %s: %d participants left", vm.Name.ValueString(), count))
+		}
+		return count == 0, nil
+	})
+	if errors.Is(err, errPollTimeout) {
+		tflog.Warn(ctx, fmt.Sprintf("Infinity worker VM %s still has %d participants after %s, deleting it anyway", vm.Name.ValueString(), participants, timeout))
+		return nil
+	}
+	if err != nil {
+		// The node is not deleted, so restore its previous maintenance mode
+		previous := map[string]any{
+			"maintenance_mode":        vm.MaintenanceMode.ValueBool(),
+			"maintenance_mode_reason": vm.MaintenanceModeReason.ValueString(),
+		}
+		if restoreErr := r.InfinityClient.PatchJSON(context.WithoutCancel(ctx), endpoint, previous, nil); restoreErr != nil {
+			return fmt.Errorf("%w. The node was left in maintenance mode, as it could not be restored: %s", err, restoreErr)
+		}
+	}
+	return err
 }
 
-func isLookupError(err error) bool {
-	return strings.Contains(err.Error(), "lookup")
+// isNode reports whether node, as the status API names a Conferencing Node in
+// alarms and participants, is the worker VM. Depending on the status it can be
+// the node's name, hostname, FQDN or IP address.
+func isNode(vm *InfinityWorkerVMResourceModel, node string) bool {
+	if node == "" {
+		return false
+	}
+	names := []string{vm.Name.ValueString(), vm.Hostname.ValueString(), vm.Address.ValueString()}
+	if vm.Domain.ValueString() != "" {
+		names = append(names, vm.Hostname.ValueString()+"."+vm.Domain.ValueString())
+	}
+	return slices.ContainsFunc(names, func(name string) bool { return name != "" && strings.EqualFold(name, node) })
 }
@@ -7,12 +7,22 @@
 package provider
 
 import (
+	"errors"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/pexip/go-infinity-sdk/v38/config"
+	"github.com/pexip/go-infinity-sdk/v38/status"
 	"github.com/pexip/go-infinity-sdk/v38/types"
+	"github.com/pexip/go-infinity-sdk/v38/util"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/pexip/go-infinity-sdk/v38"
@@ -533,3 +543,239 @@ func testInfinityWorkerVM(t *testing.T, client InfinityClient) {
 		},
 	})
 }
+
+// mockWorkerVMReadiness returns the worker VM statuses in order, one list per
+// request, repeating the last one. The Manager runs version 38.0.
+func mockWorkerVMReadiness(client *infinity.ClientMock, statuses ...[]status.WorkerVM) {
+	var mu sync.Mutex
+	calls := 0
+	client.On("GetJSON", mock.Anything, "status/v1/management_vm/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		vms := args.Get(3).(*status.ManagementVMListResponse)
+		vms.Objects = []status.ManagementVM{{Name: "manager", Primary: true, SyncStatus: "SYNCED", Version: "38.0"}}
+	}).Maybe()
+	client.On("GetJSON", mock.Anything, "status/v1/worker_vm/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		vms := args.Get(3).(*status.WorkerVMListResponse)
+		vms.Objects = statuses[min(calls, len(statuses)-1)]
+		calls++
+	}).Maybe()
+}
+
+func TestInfinityWorkerVMResource_ReadUpgradedState(t *testing.T) {
+	client := infinity.NewClientMock()
+	client.On("GetJSON", mock.Anything, "configuration/v1/worker_vm/5/", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		vm := args.Get(3).(*config.WorkerVM)
+		*vm = config.WorkerVM{ID: 5, ResourceURI: "/api/admin/configuration/v1/worker_vm/5/", Name: "worker-5"}
+	})
+	r := &InfinityWorkerVMResource{InfinityClient: client}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(t.Context(), fwresource.SchemaRequest{}, schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	require.True(t, ok)
+
+	// State written before wait_for_ready, drain_on_delete and drain_timeout
+	// existed holds nulls for them
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["resource_id"] = tftypes.NewValue(tftypes.Number, 5)
+	state := tfsdk.State{Raw: tftypes.NewValue(objectType, values), Schema: schemaResp.Schema}
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(t.Context(), fwresource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	// The refreshed state matches the schema defaults, so no update is planned
+	var refreshed InfinityWorkerVMResourceModel
+	require.False(t, resp.State.Get(t.Context(), &refreshed).HasError())
+	require.Equal(t, fwtypes.BoolValue(false), refreshed.WaitForReady)
+	require.Equal(t, fwtypes.BoolValue(false), refreshed.DrainOnDelete)
+	require.Equal(t, fwtypes.StringValue(defaultWorkerVMDrainTimeout), refreshed.DrainTimeout)
+}
+
+func TestInfinityWorkerVMResource_waitForReady(t *testing.T) {
+	vm := &InfinityWorkerVMResourceModel{
+		ResourceID: fwtypes.Int32Value(5),
+		Name:       fwtypes.StringValue("worker-5"),
+		Hostname:   fwtypes.StringValue("worker05"),
+		Domain:     fwtypes.StringValue("example.com"),
+		Address:    fwtypes.StringValue("10.0.0.15"),
+	}
+	other := status.WorkerVM{ConfigurationID: 4, Name: "worker-4", SyncStatus: "SYNCED", Version: "38.0"}
+	node := func(syncStatus, version string) status.WorkerVM {
+		return status.WorkerVM{ConfigurationID: 5, Name: "worker-5", SyncStatus: syncStatus, Version: version}
+	}
+
+	tests := []struct {
+		name     string
+		statuses [][]status.WorkerVM
+		alarms   []status.Alarm
+		wantErr  string
+	}{
+		{
+			name: "ready",
+			statuses: [][]status.WorkerVM{
+				{other},
+				{other, node("SYNCING", "37.2")},
+				{other, node("SYNCED", "37.2")},
+				{other, node("SYNCED", "38.0")},
+			},
+		},
+		{
+			name:     "not registered",
+			statuses: [][]status.WorkerVM{{other}},
+			wantErr:  "it was not ready within 50ms: it has not registered with the Manager",
+		},
+		{
+			name:     "not in sync",
+			statuses: [][]status.WorkerVM{{other, node("SYNC_FAILED", "38.0")}},
+			alarms: []status.Alarm{
+				{Name: "Certificate expired", Node: "10.0.0.10", Details: "On the Manager"},
+				{Name: "Connectivity lost", Node: "10.0.0.15", Details: "Cannot reach the Manager", TimeRaised: &util.InfinityTime{Time: time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)}},
+				{Name: "Configuration sync failed", Node: "10.0.0.15", Details: "Disk full", TimeRaised: &util.InfinityTime{Time: time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)}},
+			},
+			wantErr: "it was not ready within 50ms: its sync_status is SYNC_FAILED. Last error: Configuration sync failed: Disk full",
+		},
+		{
+			name:     "old version",
+			statuses: [][]status.WorkerVM{{other, node("SYNCED", "37.2")}},
+			wantErr:  "it was not ready within 50ms: it runs version 37.2, but the Manager runs 38.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := infinity.NewClientMock()
+			mockWorkerVMReadiness(client, tt.statuses...)
+			mockAlarms(client, tt.alarms...)
+			r := &InfinityWorkerVMResource{InfinityClient: client, pollInterval: time.Millisecond}
+
+			err := r.waitForReady(t.Context(), vm, 50*time.Millisecond)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInfinityWorkerVMResource_drain(t *testing.T) {
+	vm := &InfinityWorkerVMResourceModel{
+		ResourceID: fwtypes.Int32Value(5),
+		Name:       fwtypes.StringValue("worker-5"),
+		Hostname:   fwtypes.StringValue("worker05"),
+		Domain:     fwtypes.StringValue("example.com"),
+		Address:    fwtypes.StringValue("10.0.0.15"),
+	}
+	onNode := status.Participant{MediaNode: "10.0.0.15", SignallingNode: "10.0.0.14"}
+	signalling := status.Participant{MediaNode: "10.0.0.14", SignallingNode: "10.0.0.15"}
+	elsewhere := status.Participant{MediaNode: "10.0.0.14", SignallingNode: "10.0.0.14"}
+
+	tests := []struct {
+		name         string
+		participants [][]status.Participant
+		patchErr     error
+		listErr      error
+		restoreErr   error
+		wantErr      string
+		wantPolls    int
+	}{
+		{
+			name: "drained",
+			participants: [][]status.Participant{
+				{onNode, signalling, elsewhere},
+				{signalling, elsewhere},
+				{elsewhere},
+			},
+			wantPolls: 3,
+		},
+		{
+			// The node is deleted anyway when the drain timeout has passed
+			name:         "timeout",
+			participants: [][]status.Participant{{onNode, elsewhere}},
+		},
+		{
+			name:     "maintenance mode fails",
+			patchErr: errors.New("Forbidden"),
+			wantErr:  "could not enable maintenance mode: Forbidden",
+		},
+		{
+			// The node is not deleted, so it is taken out of maintenance mode
+			name:      "participants fail",
+			listErr:   errors.New("Internal Server Error"),
+			wantErr:   "could not list participants: Internal Server Error",
+			wantPolls: 1,
+		},
+		{
+			name:       "restore fails",
+			listErr:    errors.New("Internal Server Error"),
+			restoreErr: errors.New("Forbidden"),
+			wantErr:    "could not list participants: Internal Server Error. The node was left in maintenance mode, as it could not be restored: Forbidden",
+			wantPolls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := infinity.NewClientMock()
+			client.On("PatchJSON", mock.Anything, "configuration/v1/worker_vm/5/", map[string]any{
+				"maintenance_mode":        true,
+				"maintenance_mode_reason": "Draining before deletion",
+			}, nil).Return(tt.patchErr).Once()
+			if tt.listErr != nil {
+				client.On("PatchJSON", mock.Anything, "configuration/v1/worker_vm/5/", map[string]any{
+					"maintenance_mode":        false,
+					"maintenance_mode_reason": "",
+				}, nil).Return(tt.restoreErr).Once()
+			}
+			var mu sync.Mutex
+			polls := 0
+			client.On("GetJSON", mock.Anything, "status/v1/participant/", mock.Anything, mock.Anything).Return(tt.listErr).Run(func(args mock.Arguments) {
+				mu.Lock()
+				defer mu.Unlock()
+				polls++
+				if len(tt.participants) > 0 {
+					list := args.Get(3).(*status.ParticipantListResponse)
+					list.Objects = tt.participants[min(polls, len(tt.participants))-1]
+				}
+			}).Maybe()
+			r := &InfinityWorkerVMResource{InfinityClient: client, pollInterval: time.Millisecond}
+
+			err := r.drain(t.Context(), vm, 50*time.Millisecond)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			client.AssertExpectations(t)
+			if tt.wantPolls > 0 || tt.patchErr != nil {
+				require.Equal(t, tt.wantPolls, polls)
+			}
+		})
+	}
+}
+
+func TestIsNode(t *testing.T) {
+	vm := &InfinityWorkerVMResourceModel{
+		Name:     fwtypes.StringValue("worker-5"),
+		Hostname: fwtypes.StringValue("worker05"),
+		Domain:   fwtypes.StringValue("example.com"),
+		Address:  fwtypes.StringValue("10.0.0.15"),
+	}
+
+	for node, want := range map[string]bool{
+		"worker-5":             true,
+		"worker05":             true,
+		"WORKER05.example.com": true,
+		"10.0.0.15":            true,
+		"10.0.0.150":           false,
+		"worker-4":             false,
+		"":                     false,
+	} {
+		require.Equal(t, want, isNode(vm, node), node)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pexip/go-infinity-sdk/v38/status"
+	"github.com/pexip/go-infinity-sdk/v38/util"
+)
+
+// statusPageSize is the number of objects requested per page from the status
+// API.
+const statusPageSize = 100
+
+// listAllPages reads every page of an API list endpoint. list returns
+// the objects of one page and the URI of the next page, which is empty on the
+// last page.
+func listAllPages[T any](ctx context.Context, list func(ctx context.Context, opts *status.ListOptions) ([]T, string, error)) ([]T, error) {
+	var objects []T
+	opts := &status.ListOptions{Limit: statusPageSize}
+	for {
+		page, next, err := list(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, page...)
+		if next == "" || len(page) == 0 {
+			return objects, nil
+		}
+		opts.Offset += len(page)
+	}
+}
+
+// timeValue returns a time from the status API in RFC 3339 format, or null if
+// the API did not return it.
+func timeValue(t *util.InfinityTime) types.String {
+	if t == nil || t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// listManagementVMStatuses returns the status of every Management Node,
+// reading all pages of the status API.
+func listManagementVMStatuses(ctx context.Context, client InfinityClient) ([]status.ManagementVM, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts *status.ListOptions) ([]status.ManagementVM, string, error) {
+		page, err := client.Status().ListManagementVMs(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Objects, page.Meta.Next, nil
+	})
+}
+
+// managerVersion returns the software version of the primary Management Node.
+func managerVersion(ctx context.Context, client InfinityClient) (string, error) {
+	managementVMs, err := listManagementVMStatuses(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	for _, vm := range managementVMs {
+		if vm.Primary {
+			return vm.Version, nil
+		}
+	}
+	if len(managementVMs) > 0 {
+		return managementVMs[0].Version, nil
+	}
+	return "", errors.New("the status API did not return the Management Node")
+}
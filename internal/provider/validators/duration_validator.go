@@ -0,0 +1,55 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// DurationValidator checks if a string is a positive Go duration, e.g. `30s` or `5m`.
+type DurationValidator struct{}
+
+func (v DurationValidator) Description(ctx context.Context) string {
+	return "Value must be a positive duration, e.g. 30s or 5m"
+}
+
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a **positive duration**, e.g. `30s` or `5m`"
+}
+
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value %q is not a valid duration: %s. Use a number with a unit suffix, e.g. 30s, 5m or 1h.", value, err),
+		)
+		return
+	}
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value %q must be a positive duration.", value),
+		)
+	}
+}
+
+// Duration returns an instance of the duration validator.
+func Duration() validator.String {
+	return DurationValidator{}
+}
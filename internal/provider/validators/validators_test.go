@@ -315,3 +315,33 @@ func TestTimeValidator(t *testing.T) {
 		testNullAndUnknown(t, v)
 	})
 }
+
+func TestDurationValidator(t *testing.T) {
+	v := Duration()
+
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+	}{
+		{"valid duration - seconds", "30s", false},
+		{"valid duration - minutes", "5m", false},
+		{"valid duration - combined", "1h30m", false},
+		{"valid duration - milliseconds", "500ms", false},
+		{"invalid duration - zero", "0s", true},
+		{"invalid duration - negative", "-5s", true},
+		{"invalid duration - missing unit", "30", true},
+		{"invalid duration - unknown unit", "5d", true},
+		{"invalid duration - empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testStringValidator(t, v, tt.value, tt.expectError)
+		})
+	}
+
+	t.Run("null and unknown", func(t *testing.T) {
+		testNullAndUnknown(t, v)
+	})
+}
@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LimitPolicy controls how LimitTransport throttles requests to the Manager.
+type LimitPolicy struct {
+	MaxConcurrentRequests int      // Maximum number of requests in flight (0 = unlimited)
+	RequestsPerSecond     int      // Sustained request rate, with bursts of up to one second's worth (0 = unlimited)
+	SerializeEndpoints    []string // API endpoints, e.g. worker_vm, that only accept one write at a time
+}
+
+// LimitTransport limits the number of concurrent requests and the request rate
+// to the Manager, and serialises writes to endpoints that deadlock when they
+// are written to concurrently. A request holds its slot until the response body
+// is closed.
+type LimitTransport struct {
+	Base http.RoundTripper
+
+	semaphore chan struct{}
+	bucket    *tokenBucket
+	endpoints map[string]chan struct{}
+}
+
+// NewLimitTransport returns a LimitTransport that applies policy to requests
+// sent through base.
+func NewLimitTransport(base http.RoundTripper, policy LimitPolicy) *LimitTransport {
+	t := &LimitTransport{
+		Base:      base,
+		endpoints: make(map[string]chan struct{}, len(policy.SerializeEndpoints)),
+	}
+	if policy.MaxConcurrentRequests > 0 {
+		t.semaphore = make(chan struct{}, policy.MaxConcurrentRequests)
+	}
+	if policy.RequestsPerSecond > 0 {
+		t.bucket = newTokenBucket(policy.RequestsPerSecond, time.Now)
+	}
+	for _, endpoint := range policy.SerializeEndpoints {
+		t.endpoints[endpoint] = make(chan struct{}, 1)
+	}
+	return t
+}
+
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	var release []chan struct{}
+	releaseAll := func() {
+		for _, ch := range release {
+			<-ch
+		}
+	}
+
+	// Wait for the endpoint before taking a concurrency slot, so that queued
+	// writes do not block unrelated requests
+	if lock, ok := t.endpoints[Endpoint(req.URL.Path)]; ok && !isReadOnly(req.Method) {
+		if err := acquire(ctx, lock); err != nil {
+			return nil, err
+		}
+		release = append(release, lock)
+	}
+	if t.semaphore != nil {
+		if err := acquire(ctx, t.semaphore); err != nil {
+			releaseAll()
+			return nil, err
+		}
+		release = append(release, t.semaphore)
+	}
+	if t.bucket != nil {
+		if err := sleep(ctx, t.bucket.reserve()); err != nil {
+			releaseAll()
+			return nil, err
+		}
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil {
+		releaseAll()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: releaseAll}
+	return resp, nil
+}
+
+// Endpoint returns the API endpoint name of an Infinity API path, e.g.
+// worker_vm for /api/admin/configuration/v1/worker_vm/1/.
+func Endpoint(path string) string {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(path, "/api/admin/"), "/"), "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[2]
+}
+
+func isReadOnly(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+func acquire(ctx context.Context, ch chan struct{}) error {
+	select {
+	case ch <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// releaseOnClose releases the request's slots once the response body has been
+// consumed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
+
+// tokenBucket is a token bucket rate limiter that holds up to one second's
+// worth of tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(perSecond int, now func() time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(perSecond),
+		tokens: float64(perSecond),
+		last:   now(),
+		now:    now,
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens = min(b.rate, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
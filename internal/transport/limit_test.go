@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// inFlightServer records the highest number of concurrent requests per path
+// prefix it has seen.
+type inFlightServer struct {
+	mu      sync.Mutex
+	current map[string]int
+	peak    map[string]int
+}
+
+func (s *inFlightServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := r.Method + " " + Endpoint(r.URL.Path)
+	s.mu.Lock()
+	s.current[key]++
+	s.current["all"]++
+	s.peak[key] = max(s.peak[key], s.current[key])
+	s.peak["all"] = max(s.peak["all"], s.current["all"])
+	s.mu.Unlock()
+
+	time.Sleep(20 * time.Millisecond)
+
+	s.mu.Lock()
+	s.current[key]--
+	s.current["all"]--
+	s.mu.Unlock()
+}
+
+func runConcurrently(t *testing.T, client *http.Client, requests ...*http.Request) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for _, req := range requests {
+		wg.Add(1)
+		go func(req *http.Request) {
+			defer wg.Done()
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}(req)
+	}
+	wg.Wait()
+}
+
+func newRequest(t *testing.T, method, url string) *http.Request {
+	req, err := http.NewRequestWithContext(t.Context(), method, url, strings.NewReader("{}"))
+	require.NoError(t, err)
+	return req
+}
+
+func TestLimitTransport_MaxConcurrentRequests(t *testing.T) {
+	handler := &inFlightServer{current: map[string]int{}, peak: map[string]int{}}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := &http.Client{Transport: NewLimitTransport(http.DefaultTransport, LimitPolicy{MaxConcurrentRequests: 3})}
+	var requests []*http.Request
+	for i := 0; i < 10; i++ {
+		requests = append(requests, newRequest(t, http.MethodGet, server.URL+"/api/admin/configuration/v1/conference_alias/"))
+	}
+	runConcurrently(t, client, requests...)
+
+	require.Equal(t, 3, handler.peak["all"])
+}
+
+func TestLimitTransport_SerializeEndpoints(t *testing.T) {
+	handler := &inFlightServer{current: map[string]int{}, peak: map[string]int{}}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := &http.Client{Transport: NewLimitTransport(http.DefaultTransport, LimitPolicy{SerializeEndpoints: []string{"worker_vm"}})}
+	var requests []*http.Request
+	for i := 0; i < 4; i++ {
+		requests = append(requests,
+			newRequest(t, http.MethodPatch, server.URL+"/api/admin/configuration/v1/worker_vm/1/"),
+			newRequest(t, http.MethodGet, server.URL+"/api/admin/configuration/v1/worker_vm/1/"),
+			newRequest(t, http.MethodPost, server.URL+"/api/admin/configuration/v1/conference_alias/"),
+		)
+	}
+	runConcurrently(t, client, requests...)
+
+	require.Equal(t, 1, handler.peak["PATCH worker_vm"])
+	require.Greater(t, handler.peak["GET worker_vm"], 1)
+	require.Greater(t, handler.peak["POST conference_alias"], 1)
+}
+
+func TestLimitTransport_RequestsPerSecond(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewLimitTransport(http.DefaultTransport, LimitPolicy{RequestsPerSecond: 20})}
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		resp, err := client.Do(newRequest(t, http.MethodGet, server.URL))
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// The first 20 requests use the burst, the remaining 10 take half a second
+	require.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond)
+	require.Equal(t, int32(30), atomic.LoadInt32(&calls))
+}
+
+func TestLimitTransport_ContextCancelledWhileWaiting(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	transport := NewLimitTransport(http.DefaultTransport, LimitPolicy{MaxConcurrentRequests: 1})
+	go func() {
+		resp, err := transport.RoundTrip(newRequest(t, http.MethodGet, server.URL))
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	require.Eventually(t, func() bool { return len(transport.semaphore) == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(2, func() time.Time { return now })
+
+	require.Equal(t, time.Duration(0), bucket.reserve())
+	require.Equal(t, time.Duration(0), bucket.reserve())
+	require.Equal(t, 500*time.Millisecond, bucket.reserve())
+	require.Equal(t, time.Second, bucket.reserve())
+
+	// Tokens refill at the configured rate, up to one second's worth
+	now = now.Add(10 * time.Second)
+	require.Equal(t, time.Duration(0), bucket.reserve())
+	require.Equal(t, time.Duration(0), bucket.reserve())
+	require.Equal(t, 500*time.Millisecond, bucket.reserve())
+}
+
+func TestEndpoint(t *testing.T) {
+	require.Equal(t, "worker_vm", Endpoint("/api/admin/configuration/v1/worker_vm/1/"))
+	require.Equal(t, "system_location", Endpoint("/api/admin/configuration/v1/system_location/"))
+	require.Equal(t, "worker_vm", Endpoint("/api/admin/status/v1/worker_vm/"))
+	require.Equal(t, "", Endpoint("/oauth/token/"))
+}
@@ -0,0 +1,34 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is returned by ReadOnlyTransport for requests that could change
+// the Manager's configuration.
+var ErrReadOnly = errors.New("request blocked because the provider is read only")
+
+// ReadOnlyTransport only lets GET, HEAD and OPTIONS requests through. It is the
+// last line of defence for the read_only provider setting, should a request
+// bypass the checks in the resources.
+type ReadOnlyTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *ReadOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOnly(req.Method) {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrReadOnly)
+	}
+	return t.Base.RoundTrip(req)
+}
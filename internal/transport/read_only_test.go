@@ -0,0 +1,39 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &ReadOnlyTransport{Base: http.DefaultTransport}}
+
+	resp, err := client.Do(newRequest(t, http.MethodGet, server.URL+"/api/admin/configuration/v1/dns_server/"))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		resp, err := client.Do(newRequest(t, method, server.URL+"/api/admin/configuration/v1/dns_server/1/"))
+		if resp != nil {
+			resp.Body.Close()
+		}
+		require.ErrorIs(t, err, ErrReadOnly, method)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,221 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package transport provides http.RoundTripper implementations that are
+// chained in front of the Infinity Manager API connection.
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries     = 2
+	DefaultRetryWaitMin   = 1 * time.Second
+	DefaultRetryWaitMax   = 30 * time.Second
+	DefaultRequestTimeout = 30 * time.Second
+)
+
+// DefaultRetryStatusCodes are the HTTP status codes retried when no other
+// codes are configured.
+var DefaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,     // 429
+	http.StatusInternalServerError, // 500
+	http.StatusBadGateway,          // 502
+	http.StatusServiceUnavailable,  // 503
+	http.StatusGatewayTimeout,      // 504
+}
+
+// RetryPolicy controls how RetryTransport retries failed requests.
+type RetryPolicy struct {
+	MaxRetries       int           // Maximum number of retries after the first attempt (0 = no retries)
+	WaitMin          time.Duration // Backoff before the first retry
+	WaitMax          time.Duration // Upper bound for the exponential backoff
+	RequestTimeout   time.Duration // Timeout for each individual attempt (0 = no timeout)
+	RetryStatusCodes []int         // Response status codes that are retried
+}
+
+// DefaultRetryPolicy returns the retry policy used when the provider block
+// does not override it.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:       DefaultMaxRetries,
+		WaitMin:          DefaultRetryWaitMin,
+		WaitMax:          DefaultRetryWaitMax,
+		RequestTimeout:   DefaultRequestTimeout,
+		RetryStatusCodes: DefaultRetryStatusCodes,
+	}
+}
+
+// RetryTransport retries requests that fail with a retryable status code or a
+// network error, using exponential backoff with jitter. A Retry-After header
+// on the response takes precedence over the calculated backoff. Network errors
+// are only retried for idempotent methods, since a POST may have reached the
+// Manager before the connection failed.
+type RetryTransport struct {
+	Base   http.RoundTripper
+	Policy RetryPolicy
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A request body can only be replayed if it can be recreated
+	canRetry := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, cancel, err := t.prepareAttempt(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.Base.RoundTrip(attemptReq)
+		lastAttempt := !canRetry || attempt >= t.Policy.MaxRetries
+
+		if err != nil {
+			cancel()
+			if lastAttempt || !isIdempotent(req.Method) || req.Context().Err() != nil {
+				return nil, err
+			}
+			wait := t.backoff(attempt)
+			tflog.Debug(req.Context(), fmt.Sprintf("Retrying %s %s after error: %s", req.Method, req.URL.Path, err), map[string]interface{}{
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+			})
+			if err = sleep(req.Context(), wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if lastAttempt || !slices.Contains(t.Policy.RetryStatusCodes, resp.StatusCode) {
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		wait, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now())
+		if !ok {
+			wait = t.backoff(attempt)
+		}
+		// Drain the body so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		cancel()
+
+		tflog.Debug(req.Context(), fmt.Sprintf("Retrying %s %s after status %d", req.Method, req.URL.Path, resp.StatusCode), map[string]interface{}{
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		})
+		if err = sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+type noRequestTimeoutKey struct{}
+
+// WithoutRequestTimeout returns a context for requests that are not subject to
+// the per-attempt RequestTimeout, such as the transfer of a large file, which
+// can take much longer than an API request. The context's own deadline still
+// applies.
+func WithoutRequestTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRequestTimeoutKey{}, true)
+}
+
+// prepareAttempt clones req with a fresh body and the per-attempt timeout.
+func (t *RetryTransport) prepareAttempt(req *http.Request, attempt int) (*http.Request, context.CancelFunc, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.Policy.RequestTimeout > 0 && ctx.Value(noRequestTimeoutKey{}) == nil {
+		ctx, cancel = context.WithTimeout(ctx, t.Policy.RequestTimeout)
+	}
+
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, nil, fmt.Errorf("failed to rewind request body for retry: %w", err)
+		}
+		attemptReq.Body = body
+	}
+	return attemptReq, cancel, nil
+}
+
+// backoff returns the exponential backoff for the given attempt, with up to
+// half of it randomised to avoid many clients retrying in lockstep.
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	wait := t.Policy.WaitMin
+	for i := 0; i < attempt && wait < t.Policy.WaitMax; i++ {
+		wait *= 2
+	}
+	if t.Policy.WaitMax > 0 && wait > t.Policy.WaitMax {
+		wait = t.Policy.WaitMax
+	}
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int63n(half+1)) // #nosec G404 -- jitter does not need a secure random source
+	}
+	return wait
+}
+
+// retryAfter parses a Retry-After header value, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelOnClose releases the per-attempt context once the response body has
+// been consumed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,12 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+action "pexip_infinity_backup" "before-apply" {
+  config {
+    passphrase = "correct horse battery staple"
+    path       = "before-apply.tar.gpg"
+  }
+}
@@ -0,0 +1,13 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+action "pexip_infinity_backup_restore" "drill" {
+  config {
+    path             = "backups/pexip_backup.tar.gpg"
+    passphrase       = "correct horse battery staple"
+    confirm_hostname = "mgr02.example.com"
+  }
+}
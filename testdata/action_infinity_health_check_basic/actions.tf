@@ -0,0 +1,12 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+action "pexip_infinity_health_check" "post-apply" {
+  config {
+    alarm_levels  = ["critical", "error"]
+    ignore_alarms = ["Licence expiring"]
+  }
+}
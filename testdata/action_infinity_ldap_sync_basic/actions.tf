@@ -0,0 +1,11 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+action "pexip_infinity_ldap_sync" "sync" {
+  config {
+    timeout = "10m"
+  }
+}
@@ -0,0 +1,14 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+action "pexip_infinity_send_provisioning_email" "owners" {
+  config {
+    conference_ids = [1, 2, 3]
+    device_ids     = [7]
+    batch_size     = 2
+    batch_interval = "1s"
+  }
+}
@@ -0,0 +1,14 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+action "pexip_infinity_snapshot" "diagnostics" {
+  config {
+    path            = "snapshot.tgz"
+    limit_hours     = 4
+    end_limit_hours = 0
+    timeout         = "2h"
+  }
+}
@@ -0,0 +1,12 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+data "pexip_infinity_alarms" "all" {
+}
+
+data "pexip_infinity_alarms" "critical" {
+  level = "critical"
+}
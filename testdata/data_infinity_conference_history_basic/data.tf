@@ -0,0 +1,14 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+data "pexip_infinity_conference_history" "all" {
+}
+
+data "pexip_infinity_conference_history" "weekly" {
+  start_time = "2025-06-01T00:00:00Z"
+  end_time   = "2025-06-08T00:00:00Z"
+  name       = "weekly-standup"
+}
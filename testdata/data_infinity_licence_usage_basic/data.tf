@@ -0,0 +1,8 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+data "pexip_infinity_licence_usage" "usage" {
+}
@@ -0,0 +1,12 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+data "pexip_infinity_participant_history" "all" {
+}
+
+data "pexip_infinity_participant_history" "weekly" {
+  conference_name = "weekly-standup"
+}
@@ -0,0 +1,13 @@
+/*
+ * SPDX-FileCopyrightText: 2025 Pexip AS
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+data "pexip_infinity_worker_vm_status" "worker" {
+  name = "tf-test-worker-1"
+}
+
+data "pexip_infinity_worker_vm_statuses" "london" {
+  system_location = "London"
+}